@@ -0,0 +1,8 @@
+data "kuma_dataplane" "web" {
+  mesh = "default"
+  name = "web-1"
+}
+
+output "web_certificate_expiration" {
+  value = data.kuma_dataplane.web.mtls_certificate_expiration_time
+}
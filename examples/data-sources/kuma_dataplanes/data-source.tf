@@ -0,0 +1,24 @@
+# The online sidecars of the web service.
+data "kuma_dataplanes" "web" {
+  mesh   = "default"
+  tags   = { "kuma.io/service" = "web" }
+  kind   = "sidecar"
+  online = true
+}
+
+# Only allow traffic to the service once it has registered instances.
+resource "kuma_raw_resource" "allow_web" {
+  count = length(data.kuma_dataplanes.web.items) > 0 ? 1 : 0
+  raw_json = jsonencode({
+    type = "MeshTrafficPermission"
+    name = "allow-web"
+    mesh = "default"
+    spec = {
+      targetRef = { kind = "MeshService", name = "web" }
+      from = [{
+        targetRef = { kind = "Mesh" }
+        default   = { action = "Allow" }
+      }]
+    }
+  })
+}
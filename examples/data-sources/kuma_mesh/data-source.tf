@@ -0,0 +1,7 @@
+data "kuma_mesh" "default" {
+  name = "default"
+}
+
+output "mtls_backend" {
+  value = "${data.kuma_mesh.default.mtls_enabled_backend} (${data.kuma_mesh.default.mtls_backend_type})"
+}
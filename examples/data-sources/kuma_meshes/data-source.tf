@@ -0,0 +1,18 @@
+data "kuma_meshes" "all" {}
+
+# Enforce a timeout in every mesh with mTLS enabled.
+resource "kuma_raw_resource" "timeout" {
+  for_each = { for m in data.kuma_meshes.all.items : m.name => m if m.mtls_enabled_backend != null }
+  raw_json = jsonencode({
+    type = "MeshTimeout"
+    name = "default-timeout"
+    mesh = each.key
+    spec = {
+      targetRef = { kind = "Mesh" }
+      to = [{
+        targetRef = { kind = "Mesh" }
+        default   = { idleTimeout = "1h" }
+      }]
+    }
+  })
+}
@@ -0,0 +1,15 @@
+data "kuma_policies_for_dataplane" "web" {
+  mesh      = "default"
+  dataplane = "web-1"
+}
+
+# Fail the plan when the timeout of web doesn't resolve to the one of the platform team.
+check "web_timeout" {
+  assert {
+    condition = alltrue([
+      for rule in data.kuma_policies_for_dataplane.web.rules["MeshTimeout"].to_rules :
+      jsondecode(rule.conf).idleTimeout == "1h"
+    ])
+    error_message = "The idle timeout of web isn't 1h."
+  }
+}
@@ -0,0 +1,9 @@
+data "kuma_policy_affected_dataplanes" "timeout" {
+  type = "MeshTimeout"
+  mesh = "default"
+  name = "platform-timeout"
+}
+
+output "affected_dataplanes" {
+  value = [for dp in data.kuma_policy_affected_dataplanes.timeout.dataplanes : dp.name]
+}
@@ -0,0 +1,16 @@
+# Read a mesh managed by another team.
+data "kuma_resource" "default_mesh" {
+  type = "Mesh"
+  name = "default"
+}
+
+# Read a policy scoped to a mesh.
+data "kuma_resource" "timeout" {
+  type = "MeshTimeout"
+  mesh = "default"
+  name = "mesh-timeout-all-default"
+}
+
+output "mtls_backend" {
+  value = jsondecode(data.kuma_resource.default_mesh.raw_json).mtls.enabledBackend
+}
@@ -0,0 +1,23 @@
+# List the MeshServices of a team in the default mesh.
+data "kuma_resources" "services" {
+  type   = "MeshService"
+  mesh   = "default"
+  labels = { team = "payments" }
+}
+
+# Allow the traffic to each of them.
+resource "kuma_raw_resource" "allow" {
+  for_each = { for s in data.kuma_resources.services.items : s.name => s }
+  raw_json = jsonencode({
+    type = "MeshTrafficPermission"
+    name = "allow-${each.key}"
+    mesh = each.value.mesh
+    spec = {
+      targetRef = { kind = "MeshService", name = each.key }
+      from = [{
+        targetRef = { kind = "Mesh" }
+        default   = { action = "Allow" }
+      }]
+    }
+  })
+}
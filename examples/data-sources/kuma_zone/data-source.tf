@@ -0,0 +1,8 @@
+# The provider must use the global control-plane.
+data "kuma_zone" "east" {
+  name = "east"
+}
+
+output "east_ready" {
+  value = data.kuma_zone.east.online && data.kuma_zone.east.kds.responses_rejected == 0
+}
@@ -0,0 +1,23 @@
+# The provider must use the global control-plane.
+data "kuma_zones" "all" {}
+
+locals {
+  online_zones = [for z in data.kuma_zones.all.items : z.name if z.online]
+}
+
+# Only roll out the policy once every zone is online.
+resource "kuma_raw_resource" "timeout" {
+  count = length(local.online_zones) == length(data.kuma_zones.all.items) ? 1 : 0
+  raw_json = jsonencode({
+    type = "MeshTimeout"
+    name = "default-timeout"
+    mesh = "default"
+    spec = {
+      targetRef = { kind = "Mesh" }
+      to = [{
+        targetRef = { kind = "Mesh" }
+        default   = { idleTimeout = "1h" }
+      }]
+    }
+  })
+}
@@ -0,0 +1,14 @@
+ephemeral "kuma_dataplane_token" "web" {
+  mesh      = "default"
+  name      = "web-1"
+  tags      = { "kuma.io/service" = ["web"] }
+  valid_for = "720h"
+}
+
+# Hand the token to the VM without storing it in the state.
+resource "aws_ssm_parameter" "web_dataplane_token" {
+  name             = "/kuma/web-1/dataplane-token"
+  type             = "SecureString"
+  value_wo         = ephemeral.kuma_dataplane_token.web.token
+  value_wo_version = 1
+}
@@ -0,0 +1,21 @@
+resource "kuma_raw_resource" "east" {
+  raw_json = jsonencode({
+    type    = "Zone"
+    name    = "east"
+    enabled = true
+  })
+}
+
+ephemeral "kuma_zone_token" "east" {
+  zone      = kuma_raw_resource.east.name
+  scope     = ["cp"]
+  valid_for = "720h"
+}
+
+# Hand the token to the zone control-plane without storing it in the state.
+resource "aws_ssm_parameter" "east_zone_token" {
+  name             = "/kuma/east/zone-token"
+  type             = "SecureString"
+  value_wo         = ephemeral.kuma_zone_token.east.token
+  value_wo_version = 1
+}
@@ -13,10 +13,39 @@ variable "kuma_token" {
 provider "kuma" {
   # example configuration here
   endpoint = "http://localhost:5681"
-  # For Konnect you could use something like
-  # endpoint = "https://us.api.konghq.com/v0/mesh/control-planes/<cpId>/api"
   # Set the variable using `TF_VAR_kuma_token`
   # token    = var.kuma_token
+
+  # Retry requests failing while the control-plane restarts or rate limits
+  # max_retries            = 5
+  # retry_min_backoff      = "500ms"
+  # retry_max_backoff      = "1m"
+  # retryable_status_codes = [429, 502, 503, 504]
+
+  # Use the https api of a control-plane with a self-signed certificate
+  # endpoint     = "https://localhost:5682"
+  # ca_cert_file = "/path/to/ca.pem"
+  # And a client certificate when it requires mTLS
+  # client_cert = "/path/to/client.pem"
+  # client_key  = "/path/to/client-key.pem"
+
+  # Or reuse a context of `kumactl` instead of setting the endpoint and credentials
+  # kumactl_context = "my-cp"
+
+  # Other authentication methods, a token file read again when it's rotated
+  # token_file = "/var/run/secrets/kuma/token"
+  # or a credential plugin printing `{"token": "...", "expirationTimestamp": "..."}`
+  # exec = {
+  #   command = "get-kuma-token"
+  #   args    = ["--cp", "my-cp"]
+  # }
+
+  # For Konnect remove `endpoint` and use the `konnect` block
+  # konnect {
+  #   region             = "us"
+  #   control_plane_name = "my-cp"
+  #   # Set the token using `KONNECT_PAT`
+  # }
 }
 
 resource "kuma_raw_resource" "example" {
@@ -0,0 +1,8 @@
+resource "kuma_dataplane_token" "web" {
+  mesh      = "default"
+  name      = "web-1"
+  tags      = { "kuma.io/service" = ["web"] }
+  valid_for = "720h"
+  # Generate a new token on the plans of the last 3 days of the current one.
+  renew_before = "72h"
+}
@@ -0,0 +1,52 @@
+terraform {
+  required_providers {
+    kuma = {
+      source = "registry.terraform.io/kong/kuma"
+    }
+  }
+}
+
+provider "kuma" {
+  endpoint = "http://localhost:5681"
+}
+
+resource "kuma_mesh" "example" {
+  name                           = "backend"
+  skip_creating_initial_policies = ["*"]
+  mtls = {
+    enabled_backend = "ca-1"
+    backends = [{
+      name = "ca-1"
+      mode = "STRICT"
+      builtin = {
+        ca_cert = {
+          rsa_bits   = 2048
+          expiration = "10y"
+        }
+      }
+      dp_cert = {
+        rotation = {
+          expiration = "1d"
+        }
+      }
+    }]
+  }
+  routing = {
+    zone_egress                   = true
+    locality_aware_load_balancing = true
+  }
+  mesh_services = {
+    mode = "Exclusive"
+  }
+  tracing = {
+    default_backend = "zipkin"
+    backends = [{
+      name     = "zipkin"
+      type     = "zipkin"
+      sampling = 100
+      conf_json = jsonencode({
+        url = "http://zipkin.tracing:9411/api/v2/spans"
+      })
+    }]
+  }
+}
@@ -0,0 +1,50 @@
+terraform {
+  required_providers {
+    kuma = {
+      source = "registry.terraform.io/kong/kuma"
+    }
+  }
+}
+
+provider "kuma" {
+  endpoint = "http://localhost:5681"
+}
+
+resource "kuma_mesh_http_route" "canary" {
+  mesh = "default"
+  name = "backend-canary"
+  spec = {
+    target_ref = {
+      kind = "Mesh"
+    }
+    to = [{
+      target_ref = {
+        kind = "MeshService"
+        name = "backend"
+      }
+      rules = [{
+        matches = [{
+          path = {
+            type  = "PathPrefix"
+            value = "/api"
+          }
+        }]
+        default = {
+          backend_refs = [
+            {
+              kind   = "MeshService"
+              name   = "backend"
+              weight = 90
+            },
+            {
+              kind   = "MeshServiceSubset"
+              name   = "backend"
+              tags   = { version = "v2" }
+              weight = 10
+            },
+          ]
+        }
+      }]
+    }]
+  }
+}
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    kuma = {
+      source = "registry.terraform.io/kong/kuma"
+    }
+  }
+}
+
+provider "kuma" {
+  endpoint = "http://localhost:5681"
+}
+
+resource "kuma_mesh_timeout" "backend" {
+  mesh = "default"
+  name = "backend-timeouts"
+  spec = {
+    target_ref = {
+      kind = "Mesh"
+    }
+    to = [{
+      target_ref = {
+        kind = "MeshService"
+        name = "backend"
+      }
+      default = {
+        connection_timeout = "2s"
+        idle_timeout       = "20s"
+        http = {
+          request_timeout = "5s"
+        }
+      }
+    }]
+  }
+}
@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    kuma = {
+      source = "registry.terraform.io/kong/kuma"
+    }
+  }
+}
+
+provider "kuma" {
+  endpoint = "http://localhost:5681"
+}
+
+resource "kuma_mesh_traffic_permission" "allow_all" {
+  mesh = "default"
+  name = "allow-all"
+  spec = {
+    target_ref = {
+      kind = "Mesh"
+    }
+    from = [{
+      target_ref = {
+        kind = "Mesh"
+      }
+      default = {
+        action = "Allow"
+      }
+    }]
+  }
+}
@@ -67,3 +67,25 @@ resource "kuma_raw_resource" "tracing" {
     }
   })
 }
+
+resource "kuma_raw_resource" "mesh" {
+  raw_json = jsonencode({
+    type = "Mesh"
+    name = "backend"
+  })
+}
+
+resource "kuma_raw_resource" "mesh_policy" {
+  raw_json = jsonencode({
+    type = "MeshTrafficPermission"
+    name = "allow-all"
+    mesh = kuma_raw_resource.mesh.name
+    spec = {
+      targetRef = { kind = "Mesh" }
+      from = [{
+        targetRef = { kind = "Mesh" }
+        default   = { action = "Allow" }
+      }]
+    }
+  })
+}
@@ -0,0 +1,7 @@
+resource "kuma_zone_token" "east" {
+  zone      = "east"
+  scope     = ["cp", "ingress", "egress"]
+  valid_for = "720h"
+  # Generate a new token on the plans of the last 3 days of the current one.
+  renew_before = "72h"
+}
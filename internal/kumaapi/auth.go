@@ -0,0 +1,166 @@
+package kumaapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Authenticator sets the credentials of the requests to the control-plane.
+type Authenticator interface {
+	Authenticate(ctx context.Context, req *http.Request) error
+}
+
+// WithAuthenticator replaces the bearer token passed to NewClient.
+func WithAuthenticator(auth Authenticator) ClientOption {
+	return func(c *ClientImpl) {
+		c.auth = auth
+	}
+}
+
+type bearerToken string
+
+// BearerToken authenticates with a static token.
+func BearerToken(token string) Authenticator {
+	return bearerToken(token)
+}
+
+func (t bearerToken) Authenticate(_ context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t))
+	return nil
+}
+
+type basicAuth struct {
+	username string
+	password string
+}
+
+// BasicAuth authenticates with a username and a password.
+func BasicAuth(username string, password string) Authenticator {
+	return basicAuth{username: username, password: password}
+}
+
+func (b basicAuth) Authenticate(_ context.Context, req *http.Request) error {
+	req.SetBasicAuth(b.username, b.password)
+	return nil
+}
+
+type tokenFile struct {
+	path    string
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	token   string
+}
+
+// TokenFile authenticates with a bearer token read from a file, the file is read again when it changes
+// (e.g. a token rotated by an agent).
+func TokenFile(path string) Authenticator {
+	return &tokenFile{path: path}
+}
+
+func (t *tokenFile) Authenticate(_ context.Context, req *http.Request) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	info, err := os.Stat(t.path)
+	if err != nil {
+		return fmt.Errorf("failed to read token file error='%w'", err)
+	}
+	if t.token == "" || !info.ModTime().Equal(t.modTime) || info.Size() != t.size {
+		b, err := os.ReadFile(t.path)
+		if err != nil {
+			return fmt.Errorf("failed to read token file error='%w'", err)
+		}
+		t.token = strings.TrimSpace(string(b))
+		t.modTime = info.ModTime()
+		t.size = info.Size()
+	}
+	if t.token == "" {
+		return fmt.Errorf("token file %s is empty", t.path)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.token))
+	return nil
+}
+
+// execRefreshBefore is how long before its expiration a token of an exec credential plugin is refreshed.
+const execRefreshBefore = 30 * time.Second
+
+// ExecCommand is a credential plugin printing a token as json on stdout, either
+// `{"token": "...", "expirationTimestamp": "<RFC 3339>"}` or a kubernetes `ExecCredential`.
+type ExecCommand struct {
+	Command string
+	Args    []string
+	// Env are added to the environment of the provider.
+	Env map[string]string
+}
+
+type execCredential struct {
+	cmd       ExecCommand
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// Exec authenticates with a bearer token returned by a command, the command runs again shortly before the token
+// expires. A token without expiration is used for the lifetime of the provider.
+func Exec(cmd ExecCommand) Authenticator {
+	return &execCredential{cmd: cmd}
+}
+
+type execCredentialStatus struct {
+	Token               string     `json:"token"`
+	ExpirationTimestamp *time.Time `json:"expirationTimestamp"`
+}
+
+func (e *execCredential) Authenticate(ctx context.Context, req *http.Request) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.token == "" || (!e.expiresAt.IsZero() && time.Now().Add(execRefreshBefore).After(e.expiresAt)) {
+		if err := e.refresh(ctx); err != nil {
+			return err
+		}
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", e.token))
+	return nil
+}
+
+func (e *execCredential) refresh(ctx context.Context) error {
+	cmd := exec.CommandContext(ctx, e.cmd.Command, e.cmd.Args...)
+	cmd.Env = os.Environ()
+	for k, v := range e.cmd.Env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("credential command %s failed: %s error='%w'", e.cmd.Command, strings.TrimSpace(stderr.String()), err)
+	}
+	out := struct {
+		execCredentialStatus
+		Status *execCredentialStatus `json:"status"`
+	}{}
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return fmt.Errorf("invalid output of credential command %s error='%w'", e.cmd.Command, err)
+	}
+	status := out.execCredentialStatus
+	if out.Status != nil {
+		status = *out.Status
+	}
+	if status.Token == "" {
+		return fmt.Errorf("credential command %s didn't return a token", e.cmd.Command)
+	}
+	e.token = status.Token
+	e.expiresAt = time.Time{}
+	if status.ExpirationTimestamp != nil {
+		e.expiresAt = *status.ExpirationTimestamp
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package kumaapi
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func authorization(t *testing.T, auth Authenticator) string {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost:5681", nil)
+	if err := auth.Authenticate(context.Background(), req); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return req.Header.Get("Authorization")
+}
+
+func TestBasicAuth(t *testing.T) {
+	if got := authorization(t, BasicAuth("admin", "secret")); got != "Basic YWRtaW46c2VjcmV0" {
+		t.Errorf("unexpected authorization %q", got)
+	}
+}
+
+func TestTokenFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("first\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	auth := TokenFile(path)
+	if got := authorization(t, auth); got != "Bearer first" {
+		t.Errorf("unexpected authorization %q", got)
+	}
+
+	if err := os.WriteFile(path, []byte("rotated"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if got := authorization(t, auth); got != "Bearer rotated" {
+		t.Errorf("expected the rotated token got %q", got)
+	}
+}
+
+func TestExec(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs sh")
+	}
+	// The script counts its runs so that the token changes on every refresh.
+	script := `n=$(cat "$COUNTER" 2>/dev/null || echo 0); n=$((n+1)); echo $n > "$COUNTER"; printf '%s' "$OUTPUT" | sed "s/TOKEN/token-$n/"`
+	tests := map[string]struct {
+		output string
+		want   []string
+	}{
+		"without expiration": {
+			output: `{"token": "TOKEN"}`,
+			want:   []string{"Bearer token-1", "Bearer token-1"},
+		},
+		"valid token": {
+			output: `{"token": "TOKEN", "expirationTimestamp": "` + time.Now().Add(time.Hour).Format(time.RFC3339) + `"}`,
+			want:   []string{"Bearer token-1", "Bearer token-1"},
+		},
+		"expiring token": {
+			output: `{"token": "TOKEN", "expirationTimestamp": "` + time.Now().Add(execRefreshBefore/2).Format(time.RFC3339) + `"}`,
+			want:   []string{"Bearer token-1", "Bearer token-2"},
+		},
+		"kubernetes ExecCredential": {
+			output: `{"apiVersion": "client.authentication.k8s.io/v1", "kind": "ExecCredential", "status": {"token": "TOKEN"}}`,
+			want:   []string{"Bearer token-1"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			auth := Exec(ExecCommand{
+				Command: "sh",
+				Args:    []string{"-c", script},
+				Env:     map[string]string{"COUNTER": filepath.Join(t.TempDir(), "counter"), "OUTPUT": tt.output},
+			})
+			for i, want := range tt.want {
+				if got := authorization(t, auth); got != want {
+					t.Errorf("call %d: expected %q got %q", i, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestExecFailure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs sh")
+	}
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost:5681", nil)
+	err := Exec(ExecCommand{Command: "sh", Args: []string{"-c", "echo denied >&2; exit 1"}}).Authenticate(context.Background(), req)
+	if err == nil || err.Error() != "credential command sh failed: denied error='exit status 1'" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
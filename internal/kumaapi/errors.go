@@ -0,0 +1,93 @@
+package kumaapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// InvalidParameter is a field of a resource rejected by the control-plane.
+type InvalidParameter struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+	Rule   string `json:"rule,omitempty"`
+}
+
+// APIError is an error response of the control-plane, use errors.As to access it.
+type APIError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Title      string
+	Detail     string
+	// Instance identifies the failed request in Konnect, it's useful when contacting support.
+	Instance string
+	// InvalidParameters are the fields rejected by the validation of the control-plane.
+	InvalidParameters []InvalidParameter
+	// Body is the raw response, it's only set when it isn't a json error.
+	Body string
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("invalid http response '%d %s' for %s '%s' request", e.StatusCode, http.StatusText(e.StatusCode), e.Method, e.Path)
+	if e.Title != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Title)
+	}
+	if e.Detail != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Detail)
+	}
+	for _, p := range e.InvalidParameters {
+		msg = fmt.Sprintf("%s\n%s: %s", msg, p.Field, p.Reason)
+	}
+	if e.Instance != "" {
+		msg = fmt.Sprintf("%s (instance: %s)", msg, e.Instance)
+	}
+	if e.Body != "" {
+		msg = fmt.Sprintf("%s. Response: '%s'", msg, e.Body)
+	}
+	return msg
+}
+
+// newAPIError builds the error of a failed response, it reads both the current error format (`detail` and
+// `invalid_parameters`), the one of older versions (`details` and `causes`) and the one of the Konnect gateway
+// (`message`).
+func newAPIError(res *http.Response) *APIError {
+	out := &APIError{
+		Method:     res.Request.Method,
+		Path:       res.Request.URL.RequestURI(),
+		StatusCode: res.StatusCode,
+	}
+	b, _ := io.ReadAll(res.Body)
+	body := struct {
+		Title             string             `json:"title"`
+		Detail            string             `json:"detail"`
+		Details           string             `json:"details"`
+		Instance          string             `json:"instance"`
+		Message           string             `json:"message"`
+		InvalidParameters []InvalidParameter `json:"invalid_parameters"`
+		Causes            []struct {
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"causes"`
+	}{}
+	if err := json.Unmarshal(b, &body); err != nil || (body.Title == "" && body.Message == "") {
+		out.Body = strings.TrimSpace(string(b))
+		return out
+	}
+	out.Title = body.Title
+	if out.Title == "" {
+		out.Title = body.Message
+	}
+	out.Instance = body.Instance
+	out.Detail = body.Detail
+	if out.Detail == "" {
+		out.Detail = body.Details
+	}
+	out.InvalidParameters = body.InvalidParameters
+	for _, c := range body.Causes {
+		out.InvalidParameters = append(out.InvalidParameters, InvalidParameter{Field: c.Field, Reason: c.Message})
+	}
+	return out
+}
@@ -0,0 +1,283 @@
+package kumaapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Counter is a protobuf uint64, encoded as a json string by the control-plane.
+type Counter uint64
+
+func (c *Counter) UnmarshalJSON(b []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case nil:
+		*c = 0
+	case float64:
+		*c = Counter(v)
+	case string:
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid counter %s error='%w'", v, err)
+		}
+		*c = Counter(n)
+	default:
+		return fmt.Errorf("invalid counter %s", string(b))
+	}
+	return nil
+}
+
+// DiscoveryStats are the responses sent by the control-plane on a discovery stream.
+type DiscoveryStats struct {
+	ResponsesSent         Counter `json:"responsesSent"`
+	ResponsesAcknowledged Counter `json:"responsesAcknowledged"`
+	ResponsesRejected     Counter `json:"responsesRejected"`
+}
+
+// ZoneOverview is a zone with its insight as returned by `/zones+insights`.
+type ZoneOverview struct {
+	Name             string            `json:"name"`
+	Labels           map[string]string `json:"labels"`
+	CreationTime     *time.Time        `json:"creationTime"`
+	ModificationTime *time.Time        `json:"modificationTime"`
+	Zone             struct {
+		// Enabled is unset for zones which were never disabled.
+		Enabled *bool `json:"enabled"`
+	} `json:"zone"`
+	ZoneInsight struct {
+		Subscriptions []KDSSubscription `json:"subscriptions"`
+	} `json:"zoneInsight"`
+}
+
+// KDSSubscription is a connection of a zone control-plane to the global control-plane.
+type KDSSubscription struct {
+	ID               string     `json:"id"`
+	GlobalInstanceID string     `json:"globalInstanceId"`
+	ConnectTime      *time.Time `json:"connectTime"`
+	DisconnectTime   *time.Time `json:"disconnectTime"`
+	// Config is the configuration of the zone control-plane as a json document.
+	Config  string `json:"config"`
+	Version struct {
+		KumaCp struct {
+			Version                string `json:"version"`
+			KumaCpGlobalCompatible bool   `json:"kumaCpGlobalCompatible"`
+		} `json:"kumaCp"`
+	} `json:"version"`
+	Status struct {
+		LastUpdateTime *time.Time                `json:"lastUpdateTime"`
+		Total          DiscoveryStats            `json:"total"`
+		Stat           map[string]DiscoveryStats `json:"stat"`
+	} `json:"status"`
+}
+
+// Enabled returns false when the zone was disabled on the global control-plane.
+func (z *ZoneOverview) Enabled() bool {
+	return z.Zone.Enabled == nil || *z.Zone.Enabled
+}
+
+// LastSubscription returns the most recent connection of the zone, nil if it never connected.
+func (z *ZoneOverview) LastSubscription() *KDSSubscription {
+	subscriptions := z.ZoneInsight.Subscriptions
+	if len(subscriptions) == 0 {
+		return nil
+	}
+	return &subscriptions[len(subscriptions)-1]
+}
+
+// Online returns true when the zone is enabled and connected to the global control-plane.
+func (z *ZoneOverview) Online() bool {
+	s := z.LastSubscription()
+	return z.Enabled() && s != nil && s.ConnectTime != nil && s.DisconnectTime == nil
+}
+
+// Environment returns the environment of the zone control-plane (`kubernetes` or `universal`), empty if unknown.
+func (z *ZoneOverview) Environment() string {
+	s := z.LastSubscription()
+	if s == nil || s.Config == "" {
+		return ""
+	}
+	config := struct {
+		Environment string `json:"environment"`
+	}{}
+	if err := json.Unmarshal([]byte(s.Config), &config); err != nil {
+		return ""
+	}
+	return config.Environment
+}
+
+// ZoneOverview returns a zone with its insight, nil when the zone doesn't exist.
+func (c *ClientImpl) ZoneOverview(ctx context.Context, name string) (*ZoneOverview, error) {
+	res, err := c.FetchResource(ctx, "", "zones+insights", name)
+	if err != nil || res == nil {
+		return nil, err
+	}
+	out := &ZoneOverview{}
+	if err := json.Unmarshal(res, out); err != nil {
+		return nil, fmt.Errorf("failed to decode json error='%w'", err)
+	}
+	return out, nil
+}
+
+// ListZoneOverviews returns the zones with their insight matching the filters.
+func (c *ClientImpl) ListZoneOverviews(ctx context.Context, filters ListFilters) ([]ZoneOverview, error) {
+	list, err := c.ListResources(ctx, "", "zones+insights", filters)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ZoneOverview, len(list))
+	for i, res := range list {
+		if err := json.Unmarshal(res, &out[i]); err != nil {
+			return nil, fmt.Errorf("failed to decode json error='%w'", err)
+		}
+	}
+	return out, nil
+}
+
+// DataplaneOverview is a dataplane with its insight as returned by `dataplanes+insights`.
+type DataplaneOverview struct {
+	Name             string            `json:"name"`
+	Mesh             string            `json:"mesh"`
+	Labels           map[string]string `json:"labels"`
+	CreationTime     *time.Time        `json:"creationTime"`
+	ModificationTime *time.Time        `json:"modificationTime"`
+	Dataplane        struct {
+		Networking DataplaneNetworking `json:"networking"`
+	} `json:"dataplane"`
+	DataplaneInsight struct {
+		Subscriptions []DiscoverySubscription `json:"subscriptions"`
+		MTLS          *DataplaneMTLS          `json:"mTLS"`
+	} `json:"dataplaneInsight"`
+}
+
+// DataplaneNetworking is the networking of a dataplane.
+type DataplaneNetworking struct {
+	Address           string              `json:"address"`
+	AdvertisedAddress string              `json:"advertisedAddress"`
+	Inbound           []DataplaneInbound  `json:"inbound"`
+	Outbound          []DataplaneOutbound `json:"outbound"`
+	Gateway           *struct {
+		// Type is `BUILTIN` or `DELEGATED`, it's unset for delegated gateways.
+		Type string            `json:"type"`
+		Tags map[string]string `json:"tags"`
+	} `json:"gateway"`
+}
+
+// DataplaneInbound is a port of the dataplane receiving traffic.
+type DataplaneInbound struct {
+	Port        int               `json:"port"`
+	ServicePort int               `json:"servicePort"`
+	Address     string            `json:"address"`
+	Tags        map[string]string `json:"tags"`
+	// State is `Ready`, `NotReady` or `Ignored`, older control-planes only set Health.
+	State  string `json:"state"`
+	Health *struct {
+		Ready bool `json:"ready"`
+	} `json:"health"`
+}
+
+// Ready returns true when the inbound receives traffic.
+func (i DataplaneInbound) Ready() bool {
+	if i.State != "" {
+		return i.State == "Ready"
+	}
+	return i.Health == nil || i.Health.Ready
+}
+
+// DataplaneOutbound is a port of the dataplane sending traffic to a service.
+type DataplaneOutbound struct {
+	Port    int               `json:"port"`
+	Address string            `json:"address"`
+	Tags    map[string]string `json:"tags"`
+}
+
+// DiscoverySubscription is a connection of a dataplane to the control-plane.
+type DiscoverySubscription struct {
+	ID                     string     `json:"id"`
+	ControlPlaneInstanceID string     `json:"controlPlaneInstanceId"`
+	ConnectTime            *time.Time `json:"connectTime"`
+	DisconnectTime         *time.Time `json:"disconnectTime"`
+	Version                struct {
+		KumaDp struct {
+			Version string `json:"version"`
+		} `json:"kumaDp"`
+		Envoy struct {
+			Version          string `json:"version"`
+			KumaDpCompatible bool   `json:"kumaDpCompatible"`
+		} `json:"envoy"`
+	} `json:"version"`
+	Status struct {
+		LastUpdateTime *time.Time     `json:"lastUpdateTime"`
+		Total          DiscoveryStats `json:"total"`
+	} `json:"status"`
+}
+
+// DataplaneMTLS is the certificate of a dataplane in a mesh with mTLS.
+type DataplaneMTLS struct {
+	CertificateExpirationTime   *time.Time `json:"certificateExpirationTime"`
+	LastCertificateRegeneration *time.Time `json:"lastCertificateRegeneration"`
+	CertificateRegenerations    int        `json:"certificateRegenerations"`
+	IssuedBackend               string     `json:"issuedBackend"`
+}
+
+// GatewayType returns `builtin` or `delegated` for gateways and an empty string for sidecars.
+func (d *DataplaneOverview) GatewayType() string {
+	gateway := d.Dataplane.Networking.Gateway
+	switch {
+	case gateway == nil:
+		return ""
+	case gateway.Type == "BUILTIN":
+		return "builtin"
+	default:
+		return "delegated"
+	}
+}
+
+// LastSubscription returns the most recent connection of the dataplane, nil if it never connected.
+func (d *DataplaneOverview) LastSubscription() *DiscoverySubscription {
+	subscriptions := d.DataplaneInsight.Subscriptions
+	if len(subscriptions) == 0 {
+		return nil
+	}
+	return &subscriptions[len(subscriptions)-1]
+}
+
+// Online returns true when the dataplane is connected to the control-plane.
+func (d *DataplaneOverview) Online() bool {
+	s := d.LastSubscription()
+	return s != nil && s.ConnectTime != nil && s.DisconnectTime == nil
+}
+
+// DataplaneOverview returns a dataplane with its insight, nil when the dataplane doesn't exist.
+func (c *ClientImpl) DataplaneOverview(ctx context.Context, mesh string, name string) (*DataplaneOverview, error) {
+	res, err := c.FetchResource(ctx, mesh, "dataplanes+insights", name)
+	if err != nil || res == nil {
+		return nil, err
+	}
+	out := &DataplaneOverview{}
+	if err := json.Unmarshal(res, out); err != nil {
+		return nil, fmt.Errorf("failed to decode json error='%w'", err)
+	}
+	return out, nil
+}
+
+// ListDataplaneOverviews returns the dataplanes with their insight matching the filters, the dataplanes of all meshes
+// are returned when mesh is empty.
+func (c *ClientImpl) ListDataplaneOverviews(ctx context.Context, mesh string, filters ListFilters) ([]DataplaneOverview, error) {
+	list, err := c.ListResources(ctx, mesh, "dataplanes+insights", filters)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]DataplaneOverview, len(list))
+	for i, res := range list {
+		if err := json.Unmarshal(res, &out[i]); err != nil {
+			return nil, fmt.Errorf("failed to decode json error='%w'", err)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,144 @@
+package kumaapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const zoneOverviewOnline = `{
+	"type": "ZoneOverview",
+	"name": "zone-1",
+	"creationTime": "2024-01-01T00:00:00Z",
+	"modificationTime": "2024-01-01T00:00:00Z",
+	"zone": {"enabled": true},
+	"zoneInsight": {
+		"subscriptions": [
+			{
+				"id": "a", "globalInstanceId": "global-1",
+				"connectTime": "2024-01-01T00:00:00Z", "disconnectTime": "2024-01-02T00:00:00Z",
+				"config": "{\"environment\":\"universal\"}",
+				"version": {"kumaCp": {"version": "2.6.0"}}
+			},
+			{
+				"id": "b", "globalInstanceId": "global-1",
+				"connectTime": "2024-01-02T00:00:01Z",
+				"config": "{\"environment\":\"kubernetes\",\"mode\":\"zone\"}",
+				"version": {"kumaCp": {"version": "2.7.1", "kumaCpGlobalCompatible": true}},
+				"status": {
+					"lastUpdateTime": "2024-01-02T00:00:02Z",
+					"total": {"responsesSent": "12", "responsesAcknowledged": "11", "responsesRejected": 1},
+					"stat": {"Mesh": {"responsesSent": "2", "responsesAcknowledged": "2"}}
+				}
+			}
+		]
+	}
+}`
+
+func TestZoneOverview(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/zones+insights/zone-1": zoneOverviewOnline,
+		"/zones+insights":        `{"total": 2, "items": [` + zoneOverviewOnline + `, {"name": "zone-2", "zone": {"enabled": false}}], "next": null}`,
+	})
+	client := NewClient(srv.URL, "")
+
+	zone, err := client.ZoneOverview(context.Background(), "zone-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !zone.Enabled() || !zone.Online() || zone.Environment() != "kubernetes" {
+		t.Errorf("unexpected enabled/online/environment %t/%t/%s", zone.Enabled(), zone.Online(), zone.Environment())
+	}
+	s := zone.LastSubscription()
+	if s.ID != "b" || s.Version.KumaCp.Version != "2.7.1" || !s.Version.KumaCp.KumaCpGlobalCompatible {
+		t.Errorf("unexpected last subscription %+v", s)
+	}
+	if s.Status.Total != (DiscoveryStats{ResponsesSent: 12, ResponsesAcknowledged: 11, ResponsesRejected: 1}) {
+		t.Errorf("unexpected stats %+v", s.Status.Total)
+	}
+
+	missing, err := client.ZoneOverview(context.Background(), "zone-3")
+	if err != nil || missing != nil {
+		t.Errorf("expected no zone got %v, %v", missing, err)
+	}
+
+	zones, err := client.ListZoneOverviews(context.Background(), ListFilters{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(zones) != 2 || zones[1].Name != "zone-2" || zones[1].Enabled() || zones[1].Online() || zones[1].LastSubscription() != nil {
+		t.Errorf("unexpected zones %+v", zones)
+	}
+}
+
+const dataplaneOverview = `{
+	"type": "DataplaneOverview",
+	"mesh": "default",
+	"name": "web-1",
+	"dataplane": {"networking": {
+		"address": "10.0.0.1",
+		"inbound": [
+			{"port": 8080, "servicePort": 80, "tags": {"kuma.io/service": "web"}, "state": "Ready"},
+			{"port": 8081, "servicePort": 81, "tags": {"kuma.io/service": "web-admin"}, "health": {"ready": false}}
+		],
+		"outbound": [{"port": 10001, "tags": {"kuma.io/service": "db"}}]
+	}},
+	"dataplaneInsight": {
+		"subscriptions": [{
+			"id": "a", "controlPlaneInstanceId": "cp-1", "connectTime": "2024-01-01T00:00:00Z",
+			"version": {"kumaDp": {"version": "2.7.1"}, "envoy": {"version": "1.29.2", "kumaDpCompatible": true}},
+			"status": {"total": {"responsesSent": "4"}}
+		}],
+		"mTLS": {"certificateExpirationTime": "2024-01-02T00:00:00Z", "certificateRegenerations": 1, "issuedBackend": "ca-1"}
+	}
+}`
+
+func TestDataplaneOverview(t *testing.T) {
+	var query string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/meshes/default/dataplanes+insights/web-1":
+			_, _ = w.Write([]byte(dataplaneOverview))
+		case "/meshes/default/dataplanes+insights":
+			query = r.URL.RawQuery
+			_, _ = w.Write([]byte(`{"total": 2, "items": [` + dataplaneOverview + `, {"mesh": "default", "name": "gw-1", "dataplane": {"networking": {"gateway": {"type": "BUILTIN", "tags": {"kuma.io/service": "gw"}}}}}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL, "")
+
+	dp, err := client.DataplaneOverview(context.Background(), "default", "web-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !dp.Online() || dp.GatewayType() != "" || dp.LastSubscription().Version.Envoy.Version != "1.29.2" || dp.DataplaneInsight.MTLS.IssuedBackend != "ca-1" {
+		t.Errorf("unexpected dataplane %+v", dp)
+	}
+	inbounds := dp.Dataplane.Networking.Inbound
+	if len(inbounds) != 2 || !inbounds[0].Ready() || inbounds[1].Ready() {
+		t.Errorf("unexpected inbounds %+v", inbounds)
+	}
+
+	missing, err := client.DataplaneOverview(context.Background(), "default", "web-2")
+	if err != nil || missing != nil {
+		t.Errorf("expected no dataplane got %v, %v", missing, err)
+	}
+
+	dataplanes, err := client.ListDataplaneOverviews(context.Background(), "default", ListFilters{
+		Tags:    map[string]string{"kuma.io/zone": "east", "kuma.io/service": "web"},
+		Gateway: "false",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := "gateway=false&tag=kuma.io%2Fservice%3Aweb&tag=kuma.io%2Fzone%3Aeast"; query != want {
+		t.Errorf("expected query %s got %s", want, query)
+	}
+	if len(dataplanes) != 2 || dataplanes[1].GatewayType() != "builtin" || dataplanes[1].Online() {
+		t.Errorf("unexpected dataplanes %+v", dataplanes)
+	}
+}
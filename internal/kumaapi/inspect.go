@@ -0,0 +1,120 @@
+package kumaapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// DataplaneRules are the policies applied to a dataplane as returned by `/meshes/{mesh}/dataplanes/{name}/_rules`.
+type DataplaneRules struct {
+	Rules []PolicyRules `json:"rules"`
+}
+
+// PolicyRules are the rules computed for a dataplane from all the policies of a type.
+type PolicyRules struct {
+	Type      string      `json:"type"`
+	ToRules   []Rule      `json:"toRules"`
+	FromRules []FromRules `json:"fromRules"`
+	// ProxyRule is the rule of policies with a single `default` (e.g. MeshTrace).
+	ProxyRule *ProxyRule `json:"proxyRule"`
+	Warnings  []string   `json:"warnings"`
+}
+
+// Rule is the conf applied to the traffic matching its matchers.
+type Rule struct {
+	Matchers []RuleMatcher   `json:"matchers"`
+	Conf     json.RawMessage `json:"conf"`
+	// Origin are the policies merged into the conf.
+	Origin []ResourceKey `json:"origin"`
+}
+
+// RuleMatcher matches the traffic with a tag, Not inverts the match.
+type RuleMatcher struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Not   bool   `json:"not"`
+}
+
+// FromRules are the rules of the traffic received by an inbound.
+type FromRules struct {
+	Inbound struct {
+		Port int               `json:"port"`
+		Tags map[string]string `json:"tags"`
+	} `json:"inbound"`
+	Rules []Rule `json:"rules"`
+}
+
+// ProxyRule is the conf applied to the whole dataplane.
+type ProxyRule struct {
+	Conf   json.RawMessage `json:"conf"`
+	Origin []ResourceKey   `json:"origin"`
+}
+
+// ResourceKey identifies a resource.
+type ResourceKey struct {
+	Type string `json:"type"`
+	Mesh string `json:"mesh"`
+	Name string `json:"name"`
+}
+
+// DataplaneRules returns the policies applied to a dataplane, nil when the dataplane doesn't exist.
+func (c *ClientImpl) DataplaneRules(ctx context.Context, mesh string, name string) (*DataplaneRules, error) {
+	out := &DataplaneRules{}
+	found, err := c.inspect(ctx, fmt.Sprintf("/meshes/%s/dataplanes/%s/_rules", url.PathEscape(mesh), url.PathEscape(name)), out)
+	if err != nil || !found {
+		return nil, err
+	}
+	return out, nil
+}
+
+// inspect decodes the response of an inspect api in out, found is false when the inspected resource doesn't exist.
+func (c *ClientImpl) inspect(ctx context.Context, path string, out interface{}) (bool, error) {
+	req, err := c.baseRequest(ctx, http.MethodGet, path, "")
+	if err != nil {
+		return false, fmt.Errorf("couldn't create request for request error='%w'", err)
+	}
+	res, err := c.do(req)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, newAPIError(res)
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return false, fmt.Errorf("failed to decode json error='%w'", err)
+	}
+	return true, nil
+}
+
+// ResourceMeta is the metadata of a resource.
+type ResourceMeta struct {
+	Type   string            `json:"type"`
+	Mesh   string            `json:"mesh"`
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels"`
+}
+
+// PolicyDataplanes returns the dataplanes, gateways included, selected by a policy. resType is the api path of the
+// policy type (e.g. `meshtrafficpermissions`).
+func (c *ClientImpl) PolicyDataplanes(ctx context.Context, mesh string, resType string, name string, filters ListFilters) ([]ResourceMeta, error) {
+	path := fmt.Sprintf("%s/_resources/dataplanes", resourcePath(url.PathEscape(mesh), resType, url.PathEscape(name)))
+	list, err := c.list(ctx, path, filters)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ResourceMeta, len(list))
+	for i, res := range list {
+		if err := json.Unmarshal(res, &out[i]); err != nil {
+			return nil, fmt.Errorf("failed to decode json error='%w'", err)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,76 @@
+package kumaapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestDataplaneRules(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/meshes/default/dataplanes/web-1/_rules": `{
+			"resource": {"type": "Dataplane", "mesh": "default", "name": "web-1"},
+			"rules": [
+				{
+					"type": "MeshTimeout",
+					"toRules": [{"matchers": [{"key": "kuma.io/service", "value": "db", "not": false}], "conf": {"idleTimeout": "1h"}, "origin": [{"type": "MeshTimeout", "mesh": "default", "name": "mt-1"}]}],
+					"fromRules": [{"inbound": {"port": 8080, "tags": {"kuma.io/service": "web"}}, "rules": [{"matchers": [], "conf": {"idleTimeout": "2h"}, "origin": []}]}],
+					"warnings": []
+				},
+				{"type": "MeshTrace", "proxyRule": {"conf": {"sampling": {"overall": 10}}, "origin": [{"type": "MeshTrace", "mesh": "default", "name": "trace"}]}}
+			]
+		}`,
+	})
+	client := NewClient(srv.URL, "")
+
+	rules, err := client.DataplaneRules(context.Background(), "default", "web-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(rules.Rules) != 2 {
+		t.Fatalf("expected 2 policy types got %d", len(rules.Rules))
+	}
+	timeout := rules.Rules[0]
+	if timeout.Type != "MeshTimeout" || len(timeout.ToRules) != 1 || timeout.ToRules[0].Matchers[0].Value != "db" || timeout.ToRules[0].Origin[0].Name != "mt-1" {
+		t.Errorf("unexpected to rules %+v", timeout.ToRules)
+	}
+	if len(timeout.FromRules) != 1 || timeout.FromRules[0].Inbound.Port != 8080 || string(timeout.FromRules[0].Rules[0].Conf) != `{"idleTimeout": "2h"}` {
+		t.Errorf("unexpected from rules %+v", timeout.FromRules)
+	}
+	if trace := rules.Rules[1]; trace.ProxyRule == nil || trace.ProxyRule.Origin[0].Name != "trace" {
+		t.Errorf("unexpected proxy rule %+v", trace.ProxyRule)
+	}
+
+	missing, err := client.DataplaneRules(context.Background(), "default", "web-2")
+	if err != nil || missing != nil {
+		t.Errorf("expected no rules got %v, %v", missing, err)
+	}
+}
+
+func TestPolicyDataplanes(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/meshes/default/meshtimeouts/mt-1/_resources/dataplanes": `{
+			"total": 2,
+			"items": [
+				{"type": "Dataplane", "mesh": "default", "name": "web-1", "labels": {"kuma.io/zone": "east"}},
+				{"type": "Dataplane", "mesh": "default", "name": "gw-1"}
+			],
+			"next": null
+		}`,
+	})
+	client := NewClient(srv.URL, "")
+
+	dataplanes, err := client.PolicyDataplanes(context.Background(), "default", "meshtimeouts", "mt-1", ListFilters{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(dataplanes) != 2 || dataplanes[0].Name != "web-1" || dataplanes[0].Labels["kuma.io/zone"] != "east" || dataplanes[1].Name != "gw-1" {
+		t.Errorf("unexpected dataplanes %+v", dataplanes)
+	}
+
+	var apiErr *APIError
+	if _, err := client.PolicyDataplanes(context.Background(), "default", "meshtimeouts", "mt-2", ListFilters{}); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a not found error got %v", err)
+	}
+}
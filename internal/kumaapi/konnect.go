@@ -0,0 +1,91 @@
+package kumaapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// KonnectRegions are the Konnect regions hosting mesh control-planes.
+var KonnectRegions = []string{"us", "eu", "au", "me", "in"}
+
+// KonnectAPIURL returns the url of the Konnect api of a region.
+func KonnectAPIURL(region string) string {
+	return fmt.Sprintf("https://%s.api.konghq.com", region)
+}
+
+// KonnectControlPlane is a mesh control-plane managed by Konnect.
+type KonnectControlPlane struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// KonnectClient looks up the mesh control-planes of Konnect, the resources are then managed with a Client
+// built with the Endpoint of the control-plane.
+type KonnectClient struct {
+	client *ClientImpl
+}
+
+// NewKonnectClient returns a client of the Konnect api at apiURL authenticated with a personal access token.
+func NewKonnectClient(apiURL string, pat string, opts ...ClientOption) *KonnectClient {
+	return &KonnectClient{client: newClient(apiURL, pat, opts...)}
+}
+
+// Endpoint returns the url of the kuma api of the control-plane with the given id.
+func (k *KonnectClient) Endpoint(id string) string {
+	return fmt.Sprintf("%s/v1/mesh/control-planes/%s/api", k.client.endpoint, id)
+}
+
+// ControlPlane returns the control-plane with the given id.
+func (k *KonnectClient) ControlPlane(ctx context.Context, id string) (KonnectControlPlane, error) {
+	out := KonnectControlPlane{}
+	err := k.get(ctx, "/v1/mesh/control-planes/"+url.PathEscape(id), &out)
+	return out, err
+}
+
+// ControlPlaneByName returns the control-plane with the given name.
+func (k *KonnectClient) ControlPlaneByName(ctx context.Context, name string) (KonnectControlPlane, error) {
+	for page := 1; ; page++ {
+		resp := struct {
+			Data []KonnectControlPlane `json:"data"`
+			Meta struct {
+				Page struct {
+					Total int `json:"total"`
+					Size  int `json:"size"`
+				} `json:"page"`
+			} `json:"meta"`
+		}{}
+		if err := k.get(ctx, fmt.Sprintf("/v1/mesh/control-planes?page[size]=100&page[number]=%d", page), &resp); err != nil {
+			return KonnectControlPlane{}, err
+		}
+		for _, cp := range resp.Data {
+			if cp.Name == name {
+				return cp, nil
+			}
+		}
+		if len(resp.Data) == 0 || page*resp.Meta.Page.Size >= resp.Meta.Page.Total {
+			return KonnectControlPlane{}, fmt.Errorf("mesh control-plane `%s` not found", name)
+		}
+	}
+}
+
+func (k *KonnectClient) get(ctx context.Context, path string, out interface{}) error {
+	req, err := k.client.baseRequest(ctx, http.MethodGet, path, "")
+	if err != nil {
+		return fmt.Errorf("couldn't create request for request error='%w'", err)
+	}
+	res, err := k.client.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return newAPIError(res)
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode json error='%w'", err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package kumaapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newKonnectTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer kpat_valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "Unauthorized"}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/mesh/control-planes":
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Query().Get("page[number]") {
+			case "1":
+				_, _ = w.Write([]byte(`{"data": [{"id": "1b5c", "name": "dev"}], "meta": {"page": {"number": 1, "size": 1, "total": 2}}}`))
+			case "2":
+				_, _ = w.Write([]byte(`{"data": [{"id": "9f2a", "name": "prod"}], "meta": {"page": {"number": 2, "size": 1, "total": 2}}}`))
+			}
+		case "/v1/mesh/control-planes/9f2a":
+			_, _ = w.Write([]byte(`{"id": "9f2a", "name": "prod"}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"status": 403, "title": "Forbidden", "instance": "kong:trace:1234", "detail": "You do not have permission to perform this action"}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestKonnectControlPlane(t *testing.T) {
+	srv := newKonnectTestServer(t)
+	konnect := NewKonnectClient(srv.URL, "kpat_valid")
+
+	cp, err := konnect.ControlPlaneByName(context.Background(), "prod")
+	if err != nil || cp.ID != "9f2a" {
+		t.Fatalf("unexpected control-plane %+v %v", cp, err)
+	}
+	if got, want := konnect.Endpoint(cp.ID), srv.URL+"/v1/mesh/control-planes/9f2a/api"; got != want {
+		t.Errorf("expected %s got %s", want, got)
+	}
+	if _, err := konnect.ControlPlaneByName(context.Background(), "staging"); err == nil || err.Error() != "mesh control-plane `staging` not found" {
+		t.Errorf("unexpected error %v", err)
+	}
+	if cp, err := konnect.ControlPlane(context.Background(), "9f2a"); err != nil || cp.Name != "prod" {
+		t.Errorf("unexpected control-plane %+v %v", cp, err)
+	}
+}
+
+func TestKonnectErrors(t *testing.T) {
+	srv := newKonnectTestServer(t)
+	tests := map[string]struct {
+		pat        string
+		id         string
+		wantStatus int
+		wantError  string
+	}{
+		"invalid pat": {
+			pat:        "kpat_expired",
+			id:         "9f2a",
+			wantStatus: http.StatusUnauthorized,
+			wantError:  "invalid http response '401 Unauthorized' for GET '/v1/mesh/control-planes/9f2a' request: Unauthorized",
+		},
+		"missing permission": {
+			pat:        "kpat_valid",
+			id:         "other",
+			wantStatus: http.StatusForbidden,
+			wantError:  "invalid http response '403 Forbidden' for GET '/v1/mesh/control-planes/other' request: Forbidden: You do not have permission to perform this action (instance: kong:trace:1234)",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewKonnectClient(srv.URL, tt.pat, WithRetryPolicy(RetryPolicy{})).ControlPlane(context.Background(), tt.id)
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected an APIError got %v", err)
+			}
+			if apiErr.StatusCode != tt.wantStatus || err.Error() != tt.wantError {
+				t.Errorf("unexpected error %s", fmt.Sprint(err))
+			}
+		})
+	}
+}
@@ -8,20 +8,46 @@ import (
 	"io"
 	"net/http"
 	"strings"
+
+	"github.com/hashicorp/go-version"
 )
 
 type Resource struct {
-	Name     string
-	Path     string
-	ReadOnly bool
-	IsPolicy bool
-	IsMeshed bool
+	Name                string
+	Path                string
+	SingularDisplayName string
+	PluralDisplayName   string
+	ReadOnly            bool
+	IsPolicy            bool
+	IsMeshed            bool
+	// IsTargetRef is true for policies using `targetRef` instead of selectors.
+	IsTargetRef      bool
+	HasToTargetRef   bool
+	HasFromTargetRef bool
+	// IncludeInFederation is true when the resource is synced between zones and global by KDS.
+	IncludeInFederation bool
 }
 
 type Metadata struct {
 	Resources []Resource
 	Product   string
 	Version   string
+	// BasedOnKuma is the Kuma version of a Kong Mesh control-plane.
+	BasedOnKuma string
+}
+
+// KumaVersion returns the Kuma version of the control-plane without its pre-release, it's nil for development
+// builds (e.g. `dev-1a2b3c`).
+func (m *Metadata) KumaVersion() *version.Version {
+	v := m.Version
+	if m.BasedOnKuma != "" {
+		v = m.BasedOnKuma
+	}
+	parsed, err := version.NewVersion(v)
+	if err != nil {
+		return nil
+	}
+	return parsed.Core()
 }
 
 func (m *Metadata) ResourceForPath(path string) string {
@@ -33,6 +59,16 @@ func (m *Metadata) ResourceForPath(path string) string {
 	return ""
 }
 
+// LookupResource returns the descriptor of the resource type with the given name.
+func (m *Metadata) LookupResource(name string) (Resource, bool) {
+	for _, v := range m.Resources {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return Resource{}, false
+}
+
 func (m *Metadata) PathForResource(name string) string {
 	for _, v := range m.Resources {
 		if v.Name == name {
@@ -45,33 +81,139 @@ func (m *Metadata) PathForResource(name string) string {
 type Client interface {
 	HeartBeat(ctx context.Context) (Metadata, error)
 	FetchResource(context.Context, string, string, string) ([]byte, error)
+	// ListResources returns all the resources of a type matching the filters, following the pages of the control-plane.
+	ListResources(ctx context.Context, mesh string, resType string, filters ListFilters) ([][]byte, error)
+	// ZoneOverview returns a zone with its insight, nil when the zone doesn't exist.
+	ZoneOverview(ctx context.Context, name string) (*ZoneOverview, error)
+	ListZoneOverviews(ctx context.Context, filters ListFilters) ([]ZoneOverview, error)
+	// DataplaneOverview returns a dataplane with its insight, nil when the dataplane doesn't exist.
+	DataplaneOverview(ctx context.Context, mesh string, name string) (*DataplaneOverview, error)
+	ListDataplaneOverviews(ctx context.Context, mesh string, filters ListFilters) ([]DataplaneOverview, error)
+	// DataplaneRules returns the policies applied to a dataplane, nil when the dataplane doesn't exist.
+	DataplaneRules(ctx context.Context, mesh string, name string) (*DataplaneRules, error)
+	// PolicyDataplanes returns the dataplanes selected by a policy.
+	PolicyDataplanes(ctx context.Context, mesh string, resType string, name string, filters ListFilters) ([]ResourceMeta, error)
+	// GenerateDataplaneToken returns a token used by kuma-dp to connect to the control-plane.
+	GenerateDataplaneToken(ctx context.Context, token DataplaneTokenRequest) (string, error)
+	// GenerateZoneToken returns a token used by the components of a zone to connect to the global control-plane.
+	GenerateZoneToken(ctx context.Context, token ZoneTokenRequest) (string, error)
 	PutResource(context.Context, string, string, string, string) error
 	DeleteResource(context.Context, string, string, string) error
+	// ValidateResource submits a resource to the control-plane in dry-run mode, it's a no-op unless dry-run is enabled.
+	ValidateResource(context.Context, string, string, string, string) error
+}
+
+// coreResources are the non policy resources which are not listed by `/policies`.
+// They are only used with control-planes which don't expose `/_resources`.
+var coreResources = []Resource{
+	{Name: "Mesh", Path: "meshes"},
+	{Name: "Zone", Path: "zones"},
+	{Name: "ZoneIngress", Path: "zoneingresses"},
+	{Name: "ZoneEgress", Path: "zoneegresses"},
+	{Name: "GlobalSecret", Path: "global-secrets"},
+	{Name: "HostnameGenerator", Path: "hostnamegenerators"},
+	{Name: "Dataplane", Path: "dataplanes", IsMeshed: true},
+	{Name: "Secret", Path: "secrets", IsMeshed: true},
+	{Name: "ExternalService", Path: "external-services", IsMeshed: true},
 }
 
 type ClientImpl struct {
-	client   *http.Client
-	endpoint string
-	token    string
+	client    *http.Client
+	transport *http.Transport
+	endpoint  string
+	auth      Authenticator
+	headers   http.Header
+	// dryRunEndpoint is the path template used to validate resources, dry-run is disabled when empty.
+	dryRunEndpoint string
+	retry          RetryPolicy
+}
+
+// ClientOption customizes the client built by NewClient.
+type ClientOption func(*ClientImpl)
+
+// WithDryRun enables ValidateResource, endpoint is a path template where `{path}`, `{mesh}`, `{type}`
+// and `{name}` are replaced by the api path, the mesh, the api type and the name of the resource.
+// The endpoint must validate without writing, dry-run stays disabled when it's empty. Check it with
+// CheckDryRunEndpoint.
+func WithDryRun(endpoint string) ClientOption {
+	return func(c *ClientImpl) {
+		c.dryRunEndpoint = endpoint
+	}
+}
+
+// dryRunPath returns the path of the request validating a resource.
+func dryRunPath(endpoint string, mesh string, resType string, name string) string {
+	return strings.NewReplacer(
+		"{path}", resourcePath(mesh, resType, name),
+		"{mesh}", mesh,
+		"{type}", resType,
+		"{name}", name,
+	).Replace(endpoint)
+}
+
+// CheckDryRunEndpoint returns an error when endpoint would send the resources to their own api path, the Kuma api
+// has no dry-run mode and it would write them.
+func CheckDryRunEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return fmt.Errorf("the dry-run endpoint is empty")
+	}
+	for _, mesh := range []string{"", "mesh"} {
+		if err := checkDryRunPath(dryRunPath(endpoint, mesh, "type", "name"), mesh, "type", "name"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkDryRunPath(path string, mesh string, resType string, name string) error {
+	if p, _, _ := strings.Cut(path, "?"); strings.TrimRight(p, "/") == resourcePath(mesh, resType, name) {
+		return fmt.Errorf("the dry-run endpoint '%s' is the api path of the resource, validating would write the resource", path)
+	}
+	return nil
+}
+
+// WithHeaders adds headers to every request, they replace the headers with the same name of previous options.
+func WithHeaders(headers http.Header) ClientOption {
+	return func(c *ClientImpl) {
+		if c.headers == nil {
+			c.headers = http.Header{}
+		}
+		for k, values := range headers {
+			c.headers.Del(k)
+			for _, v := range values {
+				c.headers.Add(k, v)
+			}
+		}
+	}
+}
+
+// resourcePath returns the api path of a resource, resources without a mesh are global.
+func resourcePath(mesh string, resType string, name string) string {
+	if mesh == "" {
+		return fmt.Sprintf("/%s/%s", resType, name)
+	}
+	return fmt.Sprintf("/meshes/%s/%s/%s", mesh, resType, name)
 }
 
 func (c *ClientImpl) DeleteResource(ctx context.Context, mesh string, resType string, name string) error {
-	path := fmt.Sprintf("/meshes/%s/%s/%s", mesh, resType, name)
+	path := resourcePath(mesh, resType, name)
 	req, err := c.baseRequest(ctx, http.MethodDelete, path, "")
 	if err != nil {
 		return fmt.Errorf("couldn't create delete request error='%w'", err)
 	}
-	res, err := c.client.Do(req)
+	res, lostResponse, err := c.doRetried(req)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
-	switch res.StatusCode {
-	case http.StatusOK:
+	switch {
+	case res.StatusCode == http.StatusOK:
+		return nil
+	case res.StatusCode == http.StatusNotFound && lostResponse:
+		// A previous attempt deleted the resource but its response was lost.
 		return nil
 	default:
-		b, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("invalid http response '%s' for GET '%s' request. Response: '%s'", res.Status, path, string(b))
+		return newAPIError(res)
 	}
 }
 
@@ -85,8 +227,15 @@ func (c *ClientImpl) baseRequest(ctx context.Context, method string, path string
 	if err != nil {
 		return nil, err
 	}
-	if c.token != "" {
-		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	for k, values := range c.headers {
+		for _, v := range values {
+			req.Header.Add(k, v)
+		}
+	}
+	if c.auth != nil {
+		if err := c.auth.Authenticate(ctx, req); err != nil {
+			return nil, fmt.Errorf("failed to authenticate error='%w'", err)
+		}
 	}
 	return req, nil
 }
@@ -103,26 +252,52 @@ func (c *ClientImpl) HeartBeat(ctx context.Context) (Metadata, error) {
 	if r, ok := index["version"].(string); ok {
 		resp.Version = r
 	}
-	resources, err := c.policies(ctx)
+	if r, ok := index["basedOnKuma"].(string); ok {
+		resp.BasedOnKuma = r
+	}
+	resources, found, err := c.resources(ctx)
+	if err != nil {
+		return resp, fmt.Errorf("failed resources request, error=%w", err)
+	}
+	if found {
+		resp.Resources = resources
+		return resp, nil
+	}
+	// Older control-planes don't have `/_resources` so we only know about policies.
+	resources, err = c.policies(ctx)
 	if err != nil {
 		return resp, fmt.Errorf("failed policies request, error=%w", err)
 	}
+	for _, v := range coreResources {
+		if !containsResource(resources, v.Name) {
+			resources = append(resources, v)
+		}
+	}
 	resp.Resources = resources
 	return resp, nil
 }
 
+func containsResource(resources []Resource, name string) bool {
+	for _, v := range resources {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *ClientImpl) index(ctx context.Context) (map[string]interface{}, error) {
 	req, err := c.baseRequest(ctx, http.MethodGet, "/", "")
 	if err != nil {
 		return nil, err
 	}
-	res, err := c.client.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("invalid http response '%s'", res.Status)
+		return nil, newAPIError(res)
 	}
 	index := map[string]interface{}{}
 	err = json.NewDecoder(res.Body).Decode(&index)
@@ -132,14 +307,83 @@ func (c *ClientImpl) index(ctx context.Context) (map[string]interface{}, error)
 	return index, nil
 }
 
+type ResourcesResponse struct {
+	Resources []ResourceDescription `json:"resources"`
+}
+
+type ResourceDescription struct {
+	Name                string             `json:"name"`
+	Path                string             `json:"path"`
+	Scope               string             `json:"scope"`
+	ReadOnly            bool               `json:"readOnly"`
+	SingularDisplayName string             `json:"singularDisplayName"`
+	PluralDisplayName   string             `json:"pluralDisplayName"`
+	IncludeInFederation bool               `json:"includeInFederation"`
+	Policy              *PolicyDescription `json:"policy,omitempty"`
+}
+
+type PolicyDescription struct {
+	IsTargetRef      bool `json:"isTargetRef"`
+	HasToTargetRef   bool `json:"hasToTargetRef"`
+	HasFromTargetRef bool `json:"hasFromTargetRef"`
+	IsFromAsRules    bool `json:"isFromAsRules"`
+}
+
+// resources lists all resource types using `/_resources`, found is false when the control-plane doesn't support it.
+func (c *ClientImpl) resources(ctx context.Context) ([]Resource, bool, error) {
+	req, err := c.baseRequest(ctx, http.MethodGet, "/_resources", "")
+	if err != nil {
+		return nil, false, err
+	}
+	res, err := c.do(req)
+	if err != nil {
+		return nil, false, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, false, newAPIError(res)
+	}
+	resp := ResourcesResponse{}
+	err = json.NewDecoder(res.Body).Decode(&resp)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decode json error='%w'", err)
+	}
+	var out []Resource
+	for _, v := range resp.Resources {
+		r := Resource{
+			Name:                v.Name,
+			Path:                v.Path,
+			SingularDisplayName: v.SingularDisplayName,
+			PluralDisplayName:   v.PluralDisplayName,
+			ReadOnly:            v.ReadOnly,
+			IsMeshed:            v.Scope == "Mesh",
+			IncludeInFederation: v.IncludeInFederation,
+		}
+		if v.Policy != nil {
+			r.IsPolicy = true
+			r.IsTargetRef = v.Policy.IsTargetRef
+			r.HasToTargetRef = v.Policy.HasToTargetRef
+			r.HasFromTargetRef = v.Policy.HasFromTargetRef
+		}
+		out = append(out, r)
+	}
+	return out, true, nil
+}
+
 type PolicyResponse struct {
 	Policies []Policy `json:"policies"`
 }
 
 type Policy struct {
-	Name     string `json:"name"`
-	Path     string `json:"path"`
-	ReadOnly bool   `json:"readOnly"`
+	Name                string `json:"name"`
+	Path                string `json:"path"`
+	ReadOnly            bool   `json:"readOnly"`
+	SingularDisplayName string `json:"singularDisplayName"`
+	PluralDisplayName   string `json:"pluralDisplayName"`
+	IsTargetRefBased    bool   `json:"isTargetRefBased"`
 }
 
 func (c *ClientImpl) policies(ctx context.Context) ([]Resource, error) {
@@ -147,13 +391,13 @@ func (c *ClientImpl) policies(ctx context.Context) ([]Resource, error) {
 	if err != nil {
 		return nil, err
 	}
-	res, err := c.client.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("invalid http response '%s'", res.Status)
+		return nil, newAPIError(res)
 	}
 	resp := PolicyResponse{}
 	err = json.NewDecoder(res.Body).Decode(&resp)
@@ -163,22 +407,26 @@ func (c *ClientImpl) policies(ctx context.Context) ([]Resource, error) {
 	var out []Resource
 	for _, v := range resp.Policies {
 		out = append(out, Resource{
-			IsPolicy: true,
-			Path:     v.Path,
-			Name:     v.Name,
-			ReadOnly: v.ReadOnly,
+			IsPolicy:            true,
+			IsMeshed:            true,
+			IsTargetRef:         v.IsTargetRefBased,
+			Path:                v.Path,
+			Name:                v.Name,
+			ReadOnly:            v.ReadOnly,
+			SingularDisplayName: v.SingularDisplayName,
+			PluralDisplayName:   v.PluralDisplayName,
 		})
 	}
 	return out, nil
 }
 
 func (c *ClientImpl) FetchResource(ctx context.Context, mesh string, resType string, name string) ([]byte, error) {
-	path := fmt.Sprintf("/meshes/%s/%s/%s", mesh, resType, name)
+	path := resourcePath(mesh, resType, name)
 	req, err := c.baseRequest(ctx, http.MethodGet, path, "")
 	if err != nil {
 		return nil, fmt.Errorf("couldn't create request for request error='%w'", err)
 	}
-	res, err := c.client.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -193,19 +441,18 @@ func (c *ClientImpl) FetchResource(ctx context.Context, mesh string, resType str
 	case http.StatusNotFound:
 		return nil, nil
 	default:
-		b, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("invalid http response '%s' for GET '%s' request. Response: '%s'", res.Status, path, string(b))
+		return nil, newAPIError(res)
 	}
 }
 
 func (c *ClientImpl) PutResource(ctx context.Context, mesh string, resType string, name string, entity string) error {
-	path := fmt.Sprintf("/meshes/%s/%s/%s", mesh, resType, name)
+	path := resourcePath(mesh, resType, name)
 	req, err := c.baseRequest(ctx, http.MethodPut, path, entity)
 	if err != nil {
 		return fmt.Errorf("couldn't create request for request error='%w'", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
-	res, err := c.client.Do(req)
+	res, err := c.do(req)
 	if err != nil {
 		return err
 	}
@@ -214,19 +461,53 @@ func (c *ClientImpl) PutResource(ctx context.Context, mesh string, resType strin
 	case http.StatusOK, http.StatusCreated:
 		return nil
 	default:
-		b, _ := io.ReadAll(res.Body)
-		return fmt.Errorf("invalid http response '%s' for PUT '%s' request. Response: '%s'", res.Status, path, string(b))
+		return newAPIError(res)
+	}
+
+}
+
+func (c *ClientImpl) ValidateResource(ctx context.Context, mesh string, resType string, name string, entity string) error {
+	if c.dryRunEndpoint == "" {
+		return nil
+	}
+	path := dryRunPath(c.dryRunEndpoint, mesh, resType, name)
+	// Never write the resource during a plan, whatever the configuration.
+	if err := checkDryRunPath(path, mesh, resType, name); err != nil {
+		return err
+	}
+	req, err := c.baseRequest(ctx, http.MethodPut, path, entity)
+	if err != nil {
+		return fmt.Errorf("couldn't create request for request error='%w'", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
 	}
+	return newAPIError(res)
+}
 
+func NewClient(endpoint string, token string, opts ...ClientOption) Client {
+	return newClient(endpoint, token, opts...)
 }
 
-func NewClient(endpoint string, token string) Client {
-	if strings.HasSuffix("/", endpoint) {
-		endpoint = strings.TrimRight(endpoint, "/")
+func newClient(endpoint string, token string, opts ...ClientOption) *ClientImpl {
+	transport := newTransport()
+	c := &ClientImpl{
+		client:    &http.Client{Transport: transport},
+		transport: transport,
+		endpoint:  strings.TrimRight(endpoint, "/"),
+		retry:     DefaultRetryPolicy,
+	}
+	if token != "" {
+		c.auth = BearerToken(token)
 	}
-	return &ClientImpl{
-		client:   http.DefaultClient,
-		endpoint: endpoint,
-		token:    token,
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
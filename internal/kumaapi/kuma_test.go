@@ -0,0 +1,292 @@
+package kumaapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func newTestServer(t *testing.T, routes map[string]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHeartBeatResources(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/": `{"product": "Kuma", "version": "2.7.0"}`,
+		"/_resources": `{"resources": [
+			{"name": "Mesh", "path": "meshes", "scope": "Global", "singularDisplayName": "Mesh", "pluralDisplayName": "Meshes", "includeInFederation": true},
+			{"name": "Dataplane", "path": "dataplanes", "scope": "Mesh", "singularDisplayName": "Dataplane", "pluralDisplayName": "Dataplanes"},
+			{"name": "MeshTimeout", "path": "meshtimeouts", "scope": "Mesh", "includeInFederation": true, "policy": {"isTargetRef": true, "hasToTargetRef": true, "hasFromTargetRef": true}}
+		]}`,
+	})
+
+	metadata, err := NewClient(srv.URL, "").HeartBeat(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if metadata.Product != "Kuma" || metadata.Version != "2.7.0" {
+		t.Errorf("unexpected product/version %s/%s", metadata.Product, metadata.Version)
+	}
+	if len(metadata.Resources) != 3 {
+		t.Fatalf("expected 3 resources got %d", len(metadata.Resources))
+	}
+	mesh, ok := metadata.LookupResource("Mesh")
+	if !ok || mesh.IsMeshed || mesh.IsPolicy || !mesh.IncludeInFederation || mesh.PluralDisplayName != "Meshes" {
+		t.Errorf("unexpected mesh descriptor %+v", mesh)
+	}
+	dp, ok := metadata.LookupResource("Dataplane")
+	if !ok || !dp.IsMeshed || dp.IsPolicy {
+		t.Errorf("unexpected dataplane descriptor %+v", dp)
+	}
+	mt, ok := metadata.LookupResource("MeshTimeout")
+	if !ok || !mt.IsMeshed || !mt.IsPolicy || !mt.IsTargetRef || !mt.HasToTargetRef || !mt.HasFromTargetRef {
+		t.Errorf("unexpected policy descriptor %+v", mt)
+	}
+}
+
+func TestHeartBeatFallbackToPolicies(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/": `{"product": "Kuma", "version": "2.4.1"}`,
+		"/policies": `{"policies": [
+			{"name": "MeshTrafficPermission", "path": "meshtrafficpermissions", "isTargetRefBased": true},
+			{"name": "TrafficRoute", "path": "traffic-routes"}
+		]}`,
+	})
+
+	metadata, err := NewClient(srv.URL, "").HeartBeat(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	mtp, ok := metadata.LookupResource("MeshTrafficPermission")
+	if !ok || !mtp.IsMeshed || !mtp.IsPolicy || !mtp.IsTargetRef {
+		t.Errorf("unexpected policy descriptor %+v", mtp)
+	}
+	if metadata.PathForResource("TrafficRoute") != "traffic-routes" {
+		t.Errorf("missing old style policy")
+	}
+	mesh, ok := metadata.LookupResource("Mesh")
+	if !ok || mesh.IsMeshed {
+		t.Errorf("unexpected mesh descriptor %+v", mesh)
+	}
+	if metadata.PathForResource("Dataplane") != "dataplanes" {
+		t.Errorf("missing core resources")
+	}
+}
+
+func TestValidateResource(t *testing.T) {
+	tests := map[string]struct {
+		endpoint string
+		status   int
+		body     string
+		wantURL  string
+		want     *APIError
+	}{
+		"valid": {
+			endpoint: "{path}/_validate",
+			status:   http.StatusOK,
+			wantURL:  "/meshes/default/meshtimeouts/mt/_validate",
+		},
+		"disabled": {},
+		"invalid parameters": {
+			endpoint: "{path}/_validate",
+			status:   http.StatusBadRequest,
+			body:     `{"type": "/std-errors", "status": 400, "title": "Invalid request", "detail": "Resource is not valid", "invalid_parameters": [{"field": "spec.to[0].default.idleTimeout", "reason": "must be a valid duration"}]}`,
+			wantURL:  "/meshes/default/meshtimeouts/mt/_validate",
+			want: &APIError{
+				Method:            http.MethodPut,
+				Path:              "/meshes/default/meshtimeouts/mt/_validate",
+				StatusCode:        http.StatusBadRequest,
+				Title:             "Invalid request",
+				Detail:            "Resource is not valid",
+				InvalidParameters: []InvalidParameter{{Field: "spec.to[0].default.idleTimeout", Reason: "must be a valid duration"}},
+			},
+		},
+		"causes and custom endpoint": {
+			endpoint: "/validate/{mesh}/{type}/{name}",
+			status:   http.StatusBadRequest,
+			body:     `{"title": "Could not process a resource", "details": "Resource is not valid", "causes": [{"field": "spec.targetRef", "message": "must be defined"}]}`,
+			wantURL:  "/validate/default/meshtimeouts/mt",
+			want: &APIError{
+				Method:            http.MethodPut,
+				Path:              "/validate/default/meshtimeouts/mt",
+				StatusCode:        http.StatusBadRequest,
+				Title:             "Could not process a resource",
+				Detail:            "Resource is not valid",
+				InvalidParameters: []InvalidParameter{{Field: "spec.targetRef", Reason: "must be defined"}},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotURL string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotURL = r.URL.String()
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			err := NewClient(srv.URL, "", WithDryRun(tt.endpoint)).ValidateResource(context.Background(), "default", "meshtimeouts", "mt", `{}`)
+			if gotURL != tt.wantURL {
+				t.Errorf("expected request to %s got %s", tt.wantURL, gotURL)
+			}
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+			var invalid *APIError
+			if !errors.As(err, &invalid) {
+				t.Fatalf("expected an APIError got %v", err)
+			}
+			if diff := cmp.Diff(tt.want, invalid); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateResourceNeverWrites(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL)
+	}))
+	defer srv.Close()
+
+	for _, endpoint := range []string{"{path}?dryRun=true", "{path}/", "/meshes/{mesh}/{type}/{name}"} {
+		if err := NewClient(srv.URL, "", WithDryRun(endpoint)).ValidateResource(context.Background(), "default", "meshtimeouts", "mt", `{}`); err == nil {
+			t.Errorf("expected an error with %s", endpoint)
+		}
+	}
+	if err := NewClient(srv.URL, "", WithDryRun("/{type}/{name}")).ValidateResource(context.Background(), "", "zones", "east", `{}`); err == nil {
+		t.Errorf("expected an error for a global resource")
+	}
+}
+
+func TestCheckDryRunEndpoint(t *testing.T) {
+	tests := map[string]bool{
+		"":                               false,
+		"{path}?dryRun=true":             false,
+		"/meshes/{mesh}/{type}/{name}":   false,
+		"/{type}/{name}":                 false,
+		"{path}/_validate":               true,
+		"/validate/{mesh}/{type}/{name}": true,
+	}
+	for endpoint, valid := range tests {
+		if err := CheckDryRunEndpoint(endpoint); (err == nil) != valid {
+			t.Errorf("expected valid=%t for '%s' got %v", valid, endpoint, err)
+		}
+	}
+}
+
+func TestValidateResourceDisabled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	}))
+	defer srv.Close()
+	if err := NewClient(srv.URL, "").ValidateResource(context.Background(), "default", "meshtimeouts", "mt", `{}`); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status": 400, "title": "Invalid request", "detail": "Resource is not valid", "invalid_parameters": [{"field": "spec.from[0].default.action", "reason": "must be one of Allow, Deny", "rule": "enum"}]}`))
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte("boom\n"))
+		}
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL, "")
+
+	var apiErr *APIError
+	err := client.PutResource(context.Background(), "default", "meshtrafficpermissions", "mtp", `{}`)
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError got %v", err)
+	}
+	want := &APIError{
+		Method:            http.MethodPut,
+		Path:              "/meshes/default/meshtrafficpermissions/mtp",
+		StatusCode:        http.StatusBadRequest,
+		Title:             "Invalid request",
+		Detail:            "Resource is not valid",
+		InvalidParameters: []InvalidParameter{{Field: "spec.from[0].default.action", Reason: "must be one of Allow, Deny", Rule: "enum"}},
+	}
+	if diff := cmp.Diff(want, apiErr); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+
+	err = client.DeleteResource(context.Background(), "", "meshes", "default")
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError got %v", err)
+	}
+	if got, want := err.Error(), "invalid http response '500 Internal Server Error' for DELETE '/meshes/default' request. Response: 'boom'"; got != want {
+		t.Errorf("expected %q got %q", want, got)
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	client := NewClient(srv.URL, "token", WithHeaders(http.Header{"X-Team": []string{"mesh"}, "Authorization": []string{"Basic Zm9v"}}))
+	if _, err := client.FetchResource(context.Background(), "", "meshes", "default"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.Get("X-Team") != "mesh" {
+		t.Errorf("missing header got %v", got)
+	}
+	if v := got.Values("Authorization"); len(v) != 1 || v[0] != "Bearer token" {
+		t.Errorf("expected the token to take precedence got %v", v)
+	}
+}
+
+func TestMetadataKumaVersion(t *testing.T) {
+	tests := map[string]struct {
+		metadata Metadata
+		want     string
+	}{
+		"kuma":          {metadata: Metadata{Product: "Kuma", Version: "2.7.3"}, want: "2.7.3"},
+		"pre-release":   {metadata: Metadata{Product: "Kuma", Version: "2.9.0-rc.1"}, want: "2.9.0"},
+		"kong mesh":     {metadata: Metadata{Product: "Kong Mesh", Version: "2.7.4", BasedOnKuma: "2.7.3"}, want: "2.7.3"},
+		"dev build":     {metadata: Metadata{Product: "Kuma", Version: "dev-1a2b3c"}},
+		"empty version": {metadata: Metadata{Product: "Kuma"}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.metadata.KumaVersion()
+			if got == nil {
+				if tt.want != "" {
+					t.Errorf("expected %s got nil", tt.want)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("expected %s got %s", tt.want, got)
+			}
+		})
+	}
+}
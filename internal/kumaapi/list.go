@@ -0,0 +1,119 @@
+package kumaapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// ListFilters restricts the resources returned by ListResources.
+type ListFilters struct {
+	// Size is the number of resources requested per page, the control-plane default is used when 0.
+	Size int
+	// NameContains only keeps the resources whose name contains it.
+	NameContains string
+	// Labels only keeps the resources having all these labels.
+	Labels map[string]string
+	// Tags only keeps the dataplanes with all these tags, only for dataplanes.
+	Tags map[string]string
+	// Gateway only keeps the gateways (`true`, `builtin` or `delegated`) or the sidecars (`false`), only for dataplanes.
+	Gateway string
+}
+
+func (f ListFilters) query() url.Values {
+	q := url.Values{}
+	if f.Size > 0 {
+		q.Set("size", strconv.Itoa(f.Size))
+	}
+	if f.NameContains != "" {
+		q.Set("filter[name]", f.NameContains)
+	}
+	for k, v := range f.Labels {
+		q.Set(fmt.Sprintf("filter[label.%s]", k), v)
+	}
+	tags := make([]string, 0, len(f.Tags))
+	for k := range f.Tags {
+		tags = append(tags, k)
+	}
+	sort.Strings(tags)
+	for _, k := range tags {
+		q.Add("tag", fmt.Sprintf("%s:%s", k, f.Tags[k]))
+	}
+	if f.Gateway != "" {
+		q.Set("gateway", f.Gateway)
+	}
+	return q
+}
+
+type listResponse struct {
+	Total int               `json:"total"`
+	Items []json.RawMessage `json:"items"`
+	Next  *string           `json:"next"`
+}
+
+// listPath returns the api path listing resources, resources without a mesh are either global or listed in all meshes.
+func listPath(mesh string, resType string) string {
+	if mesh == "" {
+		return fmt.Sprintf("/%s", resType)
+	}
+	return fmt.Sprintf("/meshes/%s/%s", mesh, resType)
+}
+
+func (c *ClientImpl) ListResources(ctx context.Context, mesh string, resType string, filters ListFilters) ([][]byte, error) {
+	return c.list(ctx, listPath(mesh, resType), filters)
+}
+
+// list returns the items of all the pages of a list api.
+func (c *ClientImpl) list(ctx context.Context, path string, filters ListFilters) ([][]byte, error) {
+	query := filters.query()
+	var out [][]byte
+	for {
+		page, err := c.listPage(ctx, path, query)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Items {
+			out = append(out, item)
+		}
+		if page.Next == nil || *page.Next == "" || len(page.Items) == 0 {
+			return out, nil
+		}
+		// The link is built from the host seen by the control-plane which may differ from the endpoint
+		// (e.g. behind a proxy), only its query is kept.
+		next, err := url.Parse(*page.Next)
+		if err != nil {
+			return nil, fmt.Errorf("invalid next page link %s error='%w'", *page.Next, err)
+		}
+		if next.Query().Encode() == query.Encode() {
+			return nil, fmt.Errorf("next page link %s doesn't move forward", *page.Next)
+		}
+		query = next.Query()
+	}
+}
+
+func (c *ClientImpl) listPage(ctx context.Context, path string, query url.Values) (listResponse, error) {
+	page := listResponse{}
+	if len(query) > 0 {
+		path = path + "?" + query.Encode()
+	}
+	req, err := c.baseRequest(ctx, http.MethodGet, path, "")
+	if err != nil {
+		return page, fmt.Errorf("couldn't create request for request error='%w'", err)
+	}
+	res, err := c.do(req)
+	if err != nil {
+		return page, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return page, newAPIError(res)
+	}
+	if err := json.NewDecoder(res.Body).Decode(&page); err != nil {
+		return page, fmt.Errorf("failed to decode json error='%w'", err)
+	}
+	return page, nil
+}
@@ -0,0 +1,76 @@
+package kumaapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListResources(t *testing.T) {
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/meshes/default/meshservices" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		// The next link uses the host seen by the control-plane.
+		switch r.URL.Query().Get("offset") {
+		case "":
+			_, _ = fmt.Fprint(w, `{"total": 3, "items": [{"name": "a"}, {"name": "b"}], "next": "http://cp.internal:5681/meshes/default/meshservices?filter%5Blabel.team%5D=core&filter%5Bname%5D=svc&offset=2&size=2"}`)
+		case "2":
+			_, _ = fmt.Fprint(w, `{"total": 3, "items": [{"name": "c"}], "next": null}`)
+		}
+	}))
+	defer srv.Close()
+
+	items, err := NewClient(srv.URL, "").ListResources(context.Background(), "default", "meshservices", ListFilters{
+		Size:         2,
+		NameContains: "svc",
+		Labels:       map[string]string{"team": "core"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var got []string
+	for _, item := range items {
+		got = append(got, string(item))
+	}
+	if diff := cmp.Diff([]string{`{"name": "a"}`, `{"name": "b"}`, `{"name": "c"}`}, got); diff != "" {
+		t.Errorf("unexpected items (-want +got):\n%s", diff)
+	}
+	wantQueries := []string{
+		"filter%5Blabel.team%5D=core&filter%5Bname%5D=svc&size=2",
+		"filter%5Blabel.team%5D=core&filter%5Bname%5D=svc&offset=2&size=2",
+	}
+	if diff := cmp.Diff(wantQueries, queries); diff != "" {
+		t.Errorf("unexpected queries (-want +got):\n%s", diff)
+	}
+}
+
+func TestListResourcesGlobal(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/meshes": `{"total": 1, "items": [{"type": "Mesh", "name": "default"}], "next": ""}`,
+	})
+	items, err := NewClient(srv.URL, "").ListResources(context.Background(), "", "meshes", ListFilters{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(items) != 1 {
+		t.Errorf("expected 1 item got %d", len(items))
+	}
+}
+
+func TestListResourcesLoop(t *testing.T) {
+	srv := newTestServer(t, map[string]string{
+		"/meshes": `{"total": 2, "items": [{"type": "Mesh", "name": "default"}], "next": "http://localhost/meshes"}`,
+	})
+	if _, err := NewClient(srv.URL, "").ListResources(context.Background(), "", "meshes", ListFilters{}); err == nil {
+		t.Errorf("expected an error for a next link which doesn't move forward")
+	}
+}
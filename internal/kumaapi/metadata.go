@@ -0,0 +1,33 @@
+package kumaapi
+
+import (
+	"context"
+	"sync"
+)
+
+// MetadataCache shares the Metadata of a control-plane, it's safe for concurrent use.
+type MetadataCache struct {
+	client   Client
+	mu       sync.Mutex
+	metadata *Metadata
+}
+
+func NewMetadataCache(client Client) *MetadataCache {
+	return &MetadataCache{client: client}
+}
+
+// Get returns the metadata of the control-plane, it's only fetched on the first call or when the previous calls failed.
+// On failure the metadata retrieved before the error (e.g. the product and version) is returned.
+func (m *MetadataCache) Get(ctx context.Context) (Metadata, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.metadata != nil {
+		return *m.metadata, nil
+	}
+	metadata, err := m.client.HeartBeat(ctx)
+	if err != nil {
+		return metadata, err
+	}
+	m.metadata = &metadata
+	return metadata, nil
+}
@@ -0,0 +1,53 @@
+package kumaapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMetadataCache(t *testing.T) {
+	var calls int32
+	available := atomic.Bool{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			atomic.AddInt32(&calls, 1)
+		}
+		if !available.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		switch r.URL.Path {
+		case "/":
+			_, _ = w.Write([]byte(`{"product": "Kuma", "version": "2.7.0"}`))
+		case "/_resources":
+			_, _ = w.Write([]byte(`{"resources": [{"name": "Mesh", "path": "meshes", "scope": "Global"}]}`))
+		}
+	}))
+	defer srv.Close()
+	cache := NewMetadataCache(NewClient(srv.URL, "", WithRetryPolicy(RetryPolicy{})))
+
+	if _, err := cache.Get(context.Background()); err == nil {
+		t.Fatal("expected an error while the control-plane is unavailable")
+	}
+
+	available.Store(true)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			metadata, err := cache.Get(context.Background())
+			if err != nil || metadata.Version != "2.7.0" {
+				t.Errorf("unexpected metadata %+v %v", metadata, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 2 {
+		t.Errorf("expected the failed call and a single successful one got %d calls", calls)
+	}
+}
@@ -0,0 +1,159 @@
+package kumaapi
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RetryPolicy configures how requests failing with a transient error are retried.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt, 0 disables retries.
+	MaxRetries int
+	// MinBackoff is the wait before the first retry, it doubles on every retry up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// RetryableStatusCodes are the response codes to retry, connection errors are always retried.
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy retries rate limited requests and unavailable control-planes (e.g. during a rolling restart).
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: time.Second,
+	MaxBackoff: 30 * time.Second,
+	RetryableStatusCodes: []int{
+		http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	},
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *ClientImpl) {
+		c.retry = policy
+	}
+}
+
+func (p RetryPolicy) retryable(res *http.Response, err error) bool {
+	if err != nil {
+		// tls errors come from the configuration and won't go away with a retry.
+		var certErr *tls.CertificateVerificationError
+		var recordErr tls.RecordHeaderError
+		var opErr *net.OpError
+		if errors.As(err, &certErr) || errors.As(err, &recordErr) || (errors.As(err, &opErr) && opErr.Op == "remote error") {
+			return false
+		}
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if res.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the wait before the retry following attempt (starting at 0), a `Retry-After` header takes precedence.
+// Both are capped by MaxBackoff.
+func (p RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	d := p.MinBackoff
+	for i := 0; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if res != nil {
+		if after, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+			d = after
+		}
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d
+}
+
+// retryAfter parses a `Retry-After` header which is either a number of seconds or an http date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// do sends the request and retries it according to the retry policy. It gives up early when the wait would
+// exceed the deadline of the request's context and returns the last response or error.
+func (c *ClientImpl) do(req *http.Request) (*http.Response, error) {
+	res, _, err := c.doRetried(req)
+	return res, err
+}
+
+// doRetried is do also returning whether an attempt failed with a connection error before the last one. The
+// control-plane may have processed that attempt, only its response was lost.
+func (c *ClientImpl) doRetried(req *http.Request) (*http.Response, bool, error) {
+	ctx := req.Context()
+	lostResponse := false
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, lostResponse, err
+			}
+			req.Body = body
+		}
+		res, err := c.client.Do(req)
+		fields := map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.RequestURI(),
+			"attempt": attempt + 1,
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status_code"] = res.StatusCode
+		}
+		tflog.Debug(ctx, "control-plane request", fields)
+
+		if attempt >= c.retry.MaxRetries || !c.retry.retryable(res, err) {
+			return res, lostResponse, err
+		}
+		wait := c.retry.backoff(attempt, res)
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return res, lostResponse, err
+		}
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		} else {
+			lostResponse = true
+		}
+		fields["backoff"] = wait.String()
+		tflog.Warn(ctx, "retrying control-plane request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, lostResponse, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package kumaapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries:           2,
+		MinBackoff:           time.Millisecond,
+		MaxBackoff:           5 * time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+	}
+	tests := map[string]struct {
+		statuses     []int
+		wantAttempts int
+		wantErr      bool
+	}{
+		"succeeds after transient errors": {
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 3,
+		},
+		"gives up after max retries": {
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			wantAttempts: 3,
+			wantErr:      true,
+		},
+		"doesn't retry other errors": {
+			statuses:     []int{http.StatusInternalServerError, http.StatusOK},
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			attempts := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != `{"type": "Mesh"}` {
+					t.Errorf("unexpected body %q on attempt %d", body, attempts+1)
+				}
+				w.WriteHeader(tt.statuses[attempts])
+				attempts++
+			}))
+			defer srv.Close()
+
+			err := NewClient(srv.URL, "", WithRetryPolicy(policy)).PutResource(context.Background(), "", "meshes", "default", `{"type": "Mesh"}`)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error %v", err)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("expected %d attempts got %d", tt.wantAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRetryDeadline(t *testing.T) {
+	attempts := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := NewClient(srv.URL, "").FetchResource(ctx, "", "meshes", "default")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 1 {
+		t.Errorf("expected to give up before waiting past the deadline, got %d attempts", attempts)
+	}
+}
+
+func TestRetryDeleteLostResponse(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	tests := map[string]struct {
+		dropFirst bool
+		wantErr   bool
+	}{
+		"not found after a lost response": {dropFirst: true},
+		"not found":                       {wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			attempts := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				if tt.dropFirst && attempts == 1 {
+					// The resource is deleted but the connection is closed before responding.
+					conn, _, err := w.(http.Hijacker).Hijack()
+					if err == nil {
+						conn.Close()
+					}
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}))
+			defer srv.Close()
+
+			err := NewClient(srv.URL, "", WithRetryPolicy(policy)).DeleteResource(context.Background(), "", "meshes", "default")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := policy.backoff(attempt, nil); got != want {
+			t.Errorf("attempt %d: expected %s got %s", attempt, want, got)
+		}
+	}
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if got := policy.backoff(0, res); got != 3*time.Second {
+		t.Errorf("expected Retry-After to be honored got %s", got)
+	}
+	res = &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+	if got := policy.backoff(0, res); got != 5*time.Second {
+		t.Errorf("expected Retry-After to be capped by MaxBackoff got %s", got)
+	}
+	res = &http.Response{Header: http.Header{"Retry-After": []string{time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)}}}
+	if got := policy.backoff(0, res); got != 5*time.Second {
+		t.Errorf("expected Retry-After date to be capped by MaxBackoff got %s", got)
+	}
+}
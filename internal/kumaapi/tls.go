@@ -0,0 +1,59 @@
+package kumaapi
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// TLSOptions configures the connection to a control-plane served over https.
+type TLSOptions struct {
+	// CACert is a PEM bundle used to verify the control-plane certificate instead of the system pool.
+	CACert []byte
+	// ClientCert and ClientKey are the PEM certificate and key presented when the control-plane requires mTLS.
+	ClientCert []byte
+	ClientKey  []byte
+	// ServerName overrides the name used to verify the control-plane certificate.
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// Config builds the tls.Config of the options.
+func (o TLSOptions) Config() (*tls.Config, error) {
+	cfg := &tls.Config{
+		ServerName:         o.ServerName,
+		InsecureSkipVerify: o.InsecureSkipVerify, //nolint:gosec // opt-in for self-signed control-planes
+	}
+	if len(o.CACert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(o.CACert) {
+			return nil, errors.New("no valid PEM certificate found in the CA certificate")
+		}
+		cfg.RootCAs = pool
+	}
+	if len(o.ClientCert) > 0 || len(o.ClientKey) > 0 {
+		if len(o.ClientCert) == 0 || len(o.ClientKey) == 0 {
+			return nil, errors.New("both a client certificate and a client key are needed")
+		}
+		cert, err := tls.X509KeyPair(o.ClientCert, o.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate error='%w'", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+// newTransport returns a transport with the defaults of http.DefaultTransport which isn't shared with other clients.
+func newTransport() *http.Transport {
+	return http.DefaultTransport.(*http.Transport).Clone()
+}
+
+// WithTLS sets the tls configuration used to connect to the control-plane.
+func WithTLS(cfg *tls.Config) ClientOption {
+	return func(c *ClientImpl) {
+		c.transport.TLSClientConfig = cfg
+	}
+}
@@ -0,0 +1,116 @@
+package kumaapi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTLSTestServer(t *testing.T, clientAuth tls.ClientAuthType) (*httptest.Server, []byte) {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"type": "Mesh", "name": "default"}`))
+	}))
+	srv.TLS = &tls.Config{ClientAuth: clientAuth}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	return srv, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+}
+
+func newClientCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func TestTLS(t *testing.T) {
+	clientCert, clientKey := newClientCertificate(t)
+	tests := map[string]struct {
+		clientAuth tls.ClientAuthType
+		opts       func(caCert []byte) TLSOptions
+		wantErr    bool
+	}{
+		"unknown authority": {
+			opts:    func(caCert []byte) TLSOptions { return TLSOptions{} },
+			wantErr: true,
+		},
+		"custom CA": {
+			opts: func(caCert []byte) TLSOptions { return TLSOptions{CACert: caCert} },
+		},
+		"insecure skip verify": {
+			opts: func(caCert []byte) TLSOptions { return TLSOptions{InsecureSkipVerify: true} },
+		},
+		"server name mismatch": {
+			opts:    func(caCert []byte) TLSOptions { return TLSOptions{CACert: caCert, ServerName: "kuma-control-plane"} },
+			wantErr: true,
+		},
+		"missing client certificate": {
+			clientAuth: tls.RequireAnyClientCert,
+			opts:       func(caCert []byte) TLSOptions { return TLSOptions{CACert: caCert} },
+			wantErr:    true,
+		},
+		"client certificate": {
+			clientAuth: tls.RequireAnyClientCert,
+			opts: func(caCert []byte) TLSOptions {
+				return TLSOptions{CACert: caCert, ClientCert: clientCert, ClientKey: clientKey}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv, caCert := newTLSTestServer(t, tt.clientAuth)
+			cfg, err := tt.opts(caCert).Config()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			client := NewClient(srv.URL, "", WithTLS(cfg))
+			_, err = client.FetchResource(context.Background(), "", "meshes", "default")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestTLSOptionsInvalid(t *testing.T) {
+	clientCert, _ := newClientCertificate(t)
+	for name, opts := range map[string]TLSOptions{
+		"invalid CA":         {CACert: []byte("not a certificate")},
+		"missing client key": {ClientCert: clientCert},
+		"invalid client key": {ClientCert: clientCert, ClientKey: []byte("not a key")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := opts.Config(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
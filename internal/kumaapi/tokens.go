@@ -0,0 +1,89 @@
+package kumaapi
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DataplaneTokenRequest is the body of `/tokens/dataplane`, it's the same as `kumactl generate dataplane-token`.
+type DataplaneTokenRequest struct {
+	// Name restricts the token to the dataplane with this name, any name is accepted when empty.
+	Name string `json:"name,omitempty"`
+	Mesh string `json:"mesh"`
+	// Tags restricts the token to the dataplanes with these tags.
+	Tags map[string][]string `json:"tags,omitempty"`
+	// Type is the type of the proxy (e.g. `dataplane` or `ingress`), it defaults to `dataplane`.
+	Type     string `json:"type,omitempty"`
+	ValidFor string `json:"validFor"`
+}
+
+// GenerateDataplaneToken returns a token used by kuma-dp to connect to the control-plane.
+func (c *ClientImpl) GenerateDataplaneToken(ctx context.Context, token DataplaneTokenRequest) (string, error) {
+	return c.generateToken(ctx, "/tokens/dataplane", token)
+}
+
+// ZoneTokenRequest is the body of `/tokens/zone`, it's the same as `kumactl generate zone-token`.
+type ZoneTokenRequest struct {
+	Zone string `json:"zone"`
+	// Scope are the components of the zone allowed to use the token (`cp`, `ingress` and `egress`), all of them when empty.
+	Scope    []string `json:"scope,omitempty"`
+	ValidFor string   `json:"validFor"`
+}
+
+// GenerateZoneToken returns a token used by the control-plane, the ingress or the egress of a zone to connect to the
+// global control-plane.
+func (c *ClientImpl) GenerateZoneToken(ctx context.Context, token ZoneTokenRequest) (string, error) {
+	return c.generateToken(ctx, "/tokens/zone", token)
+}
+
+// generateToken posts the token request to path and returns the token in the response.
+func (c *ClientImpl) generateToken(ctx context.Context, path string, token interface{}) (string, error) {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode json error='%w'", err)
+	}
+	req, err := c.baseRequest(ctx, http.MethodPost, path, string(b))
+	if err != nil {
+		return "", fmt.Errorf("couldn't create request for request error='%w'", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := c.do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", newAPIError(res)
+	}
+	out, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response error='%w'", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// TokenExpiration returns the expiration of a token generated by the control-plane from its `exp` claim, the
+// signature isn't verified. ok is false when the token isn't a JWT or doesn't expire.
+func TokenExpiration(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+	claims := struct {
+		Exp *int64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == nil {
+		return time.Time{}, false
+	}
+	return time.Unix(*claims.Exp, 0).UTC(), true
+}
@@ -0,0 +1,111 @@
+package kumaapi
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGenerateDataplaneToken(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/tokens/dataplane" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("a.b.c\n"))
+	}))
+	defer srv.Close()
+	client := NewClient(srv.URL, "")
+
+	token, err := client.GenerateDataplaneToken(context.Background(), DataplaneTokenRequest{
+		Mesh:     "default",
+		Tags:     map[string][]string{"kuma.io/service": {"web"}},
+		ValidFor: "24h",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token != "a.b.c" {
+		t.Errorf("expected token a.b.c got %q", token)
+	}
+	if want := `{"mesh":"default","tags":{"kuma.io/service":["web"]},"validFor":"24h"}`; body != want {
+		t.Errorf("expected body %s got %s", want, body)
+	}
+}
+
+func TestGenerateTokenError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"title": "Could not issue a token", "details": "bad request", "causes": [{"field": "validFor", "message": "must be positive"}]}`))
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(srv.URL, "").GenerateDataplaneToken(context.Background(), DataplaneTokenRequest{Mesh: "default", ValidFor: "-1h"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected a bad request error got %v", err)
+	}
+	if len(apiErr.InvalidParameters) != 1 || apiErr.InvalidParameters[0].Field != "validFor" {
+		t.Errorf("unexpected invalid parameters %+v", apiErr.InvalidParameters)
+	}
+}
+
+func TestTokenExpiration(t *testing.T) {
+	jwt := func(payload string) string {
+		return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".c2ln"
+	}
+	tests := []struct {
+		name   string
+		token  string
+		want   time.Time
+		wantOk bool
+	}{
+		{name: "expiring", token: jwt(`{"Name": "web-1", "exp": 1704067200}`), want: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), wantOk: true},
+		{name: "no expiration", token: jwt(`{"Name": "web-1"}`)},
+		{name: "not a jwt", token: "token"},
+		{name: "invalid payload", token: "a.!.c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := TokenExpiration(tt.token)
+			if ok != tt.wantOk || !got.Equal(tt.want) {
+				t.Errorf("expected %s, %t got %s, %t", tt.want, tt.wantOk, got, ok)
+			}
+		})
+	}
+}
+
+func TestGenerateZoneToken(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/tokens/zone" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		_, _ = w.Write([]byte("a.b.c"))
+	}))
+	defer srv.Close()
+
+	token, err := NewClient(srv.URL, "").GenerateZoneToken(context.Background(), ZoneTokenRequest{Zone: "east", Scope: []string{"cp", "ingress"}, ValidFor: "720h"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token != "a.b.c" {
+		t.Errorf("expected token a.b.c got %q", token)
+	}
+	if want := `{"zone":"east","scope":["cp","ingress"],"validFor":"720h"}`; body != want {
+		t.Errorf("expected body %s got %s", want, body)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package kumactl reads the control-plane contexts of the kumactl configuration.
+package kumactl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultConfigPath is where kumactl stores its configuration, relative to the home directory.
+const DefaultConfigPath = ".kumactl/config"
+
+// Config is the configuration file of kumactl.
+type Config struct {
+	Contexts       []Context      `yaml:"contexts"`
+	ControlPlanes  []ControlPlane `yaml:"controlPlanes"`
+	CurrentContext string         `yaml:"currentContext"`
+}
+
+type Context struct {
+	Name         string `yaml:"name"`
+	ControlPlane string `yaml:"controlPlane"`
+}
+
+type ControlPlane struct {
+	Name        string `yaml:"name"`
+	Coordinates struct {
+		ApiServer ApiServer `yaml:"apiServer"`
+	} `yaml:"coordinates"`
+}
+
+// ApiServer is how kumactl connects to a control-plane.
+type ApiServer struct {
+	URL     string   `yaml:"url"`
+	Headers []Header `yaml:"headers"`
+	// AuthType is the authentication plugin, only `tokens` (a bearer token in `AuthConf["token"]`) is supported.
+	AuthType       string            `yaml:"authType"`
+	AuthConf       map[string]string `yaml:"authConf"`
+	CACertFile     string            `yaml:"caCertFile"`
+	ClientCertFile string            `yaml:"clientCertFile"`
+	ClientKeyFile  string            `yaml:"clientKeyFile"`
+	SkipVerify     bool              `yaml:"skipVerify"`
+}
+
+type Header struct {
+	Key   string `yaml:"key"`
+	Value string `yaml:"value"`
+}
+
+// Token returns the bearer token of the control-plane, it's empty without authentication.
+func (a ApiServer) Token() (string, error) {
+	switch a.AuthType {
+	case "":
+		return "", nil
+	case "tokens":
+		return a.AuthConf["token"], nil
+	default:
+		return "", fmt.Errorf("auth type `%s` isn't supported, only `tokens` is", a.AuthType)
+	}
+}
+
+// Load reads the kumactl configuration at path, `~` is expanded to the home directory and an empty path
+// is DefaultConfigPath.
+func Load(path string) (*Config, error) {
+	path, err := expand(path)
+	if err != nil {
+		return nil, err
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kumactl config error='%w'", err)
+	}
+	cfg := &Config{}
+	if err := yaml.Unmarshal(b, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse kumactl config %s error='%w'", path, err)
+	}
+	return cfg, nil
+}
+
+// ApiServer returns the control-plane of the context name, the current context is used when name is empty.
+func (c *Config) ApiServer(name string) (ApiServer, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	var names []string
+	for _, ctx := range c.Contexts {
+		names = append(names, ctx.Name)
+		if ctx.Name != name {
+			continue
+		}
+		for _, cp := range c.ControlPlanes {
+			if cp.Name == ctx.ControlPlane {
+				return cp.Coordinates.ApiServer, nil
+			}
+		}
+		return ApiServer{}, fmt.Errorf("control-plane `%s` of context `%s` not found", ctx.ControlPlane, name)
+	}
+	return ApiServer{}, fmt.Errorf("context `%s` not found, available contexts: %s", name, strings.Join(names, ", "))
+}
+
+func expand(path string) (string, error) {
+	if path != "" && path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	if path == "" {
+		return filepath.Join(home, DefaultConfigPath), nil
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
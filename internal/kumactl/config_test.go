@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kumactl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const config = `
+contexts:
+- controlPlane: local
+  name: local
+- controlPlane: prod
+  name: prod
+- controlPlane: deleted
+  name: broken
+controlPlanes:
+- coordinates:
+    apiServer:
+      url: http://localhost:5681
+  name: local
+- coordinates:
+    apiServer:
+      url: https://kuma.example.com:5682
+      authType: tokens
+      authConf:
+        token: secret
+      headers:
+      - key: X-Team
+        value: mesh
+      caCertFile: /etc/kuma/ca.pem
+  name: prod
+currentContext: prod
+`
+
+func TestApiServer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tests := map[string]struct {
+		context string
+		want    ApiServer
+		wantErr string
+	}{
+		"current context": {
+			want: ApiServer{
+				URL:        "https://kuma.example.com:5682",
+				Headers:    []Header{{Key: "X-Team", Value: "mesh"}},
+				AuthType:   "tokens",
+				AuthConf:   map[string]string{"token": "secret"},
+				CACertFile: "/etc/kuma/ca.pem",
+			},
+		},
+		"named context": {
+			context: "local",
+			want:    ApiServer{URL: "http://localhost:5681"},
+		},
+		"unknown context": {
+			context: "staging",
+			wantErr: "context `staging` not found, available contexts: local, prod, broken",
+		},
+		"missing control-plane": {
+			context: "broken",
+			wantErr: "control-plane `deleted` of context `broken` not found",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := cfg.ApiServer(tt.context)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestToken(t *testing.T) {
+	token, err := ApiServer{AuthType: "tokens", AuthConf: map[string]string{"token": "secret"}}.Token()
+	if err != nil || token != "secret" {
+		t.Errorf("unexpected token %q %v", token, err)
+	}
+	if _, err := (ApiServer{AuthType: "oidc"}).Token(); err == nil {
+		t.Error("expected unsupported auth type to fail")
+	}
+}
+
+func TestLoadDefaultPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	if err := os.MkdirAll(filepath.Join(home, ".kumactl"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(home, DefaultConfigPath), []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"", "~/.kumactl/config"} {
+		cfg, err := Load(path)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", path, err)
+		}
+		if cfg.CurrentContext != "prod" {
+			t.Errorf("unexpected config %+v", cfg)
+		}
+	}
+}
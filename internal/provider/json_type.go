@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ basetypes.StringTypable = KumaJSONType{}
+var _ basetypes.StringValuableWithSemanticEquals = KumaJSONValue{}
+
+// systemLabels are the labels added by the control-plane.
+var systemLabels = []string{
+	"kuma.io/origin",
+	"kuma.io/mesh",
+	"kuma.io/zone",
+	"kuma.io/env",
+	"kuma.io/display-name",
+	"kuma.io/policy-role",
+	"k8s.kuma.io/namespace",
+}
+
+// KumaJSONType is the type of attributes holding a kuma resource in json.
+type KumaJSONType struct {
+	basetypes.StringType
+}
+
+func (t KumaJSONType) Equal(o attr.Type) bool {
+	other, ok := o.(KumaJSONType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t KumaJSONType) String() string {
+	return "KumaJSONType"
+}
+
+func (t KumaJSONType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return KumaJSONValue{StringValue: in}, nil
+}
+
+func (t KumaJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return KumaJSONValue{StringValue: stringValue}, nil
+}
+
+func (t KumaJSONType) ValueType(ctx context.Context) attr.Value {
+	return KumaJSONValue{}
+}
+
+// KumaJSONValue is a kuma resource in json. A value returned by the control-plane is semantically equal to the
+// configured one when it only differs by formatting, key ordering, server defaults, timestamps or system labels.
+type KumaJSONValue struct {
+	basetypes.StringValue
+}
+
+func NewKumaJSONValue(value string) KumaJSONValue {
+	return KumaJSONValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v KumaJSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(KumaJSONValue)
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+func (v KumaJSONValue) Type(ctx context.Context) attr.Type {
+	return KumaJSONType{}
+}
+
+// StringSemanticEquals is called with v the planned or prior value and newValuable the value read from the control-plane.
+func (v KumaJSONValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(KumaJSONValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	return jsonSemanticallyEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// jsonSemanticallyEqual returns whether observed only adds server managed data to desired.
+func jsonSemanticallyEqual(desired string, observed string) bool {
+	d, err := normalizedJSON(desired)
+	if err != nil {
+		return desired == observed
+	}
+	o, err := normalizedJSON(observed)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(d, onlyManagedFields(o, d))
+}
+
+// normalizedJSON decodes a resource without the fields managed by the control-plane.
+func normalizedJSON(s string) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+	d := json.NewDecoder(bytes.NewReader([]byte(s)))
+	d.UseNumber()
+	if err := d.Decode(&m); err != nil {
+		return nil, err
+	}
+	delete(m, "creationTime")
+	delete(m, "modificationTime")
+	dropSystemLabels(m)
+	return m, nil
+}
+
+// dropSystemLabels removes the labels added by the control-plane from a resource, and its labels when none are left.
+func dropSystemLabels(m map[string]interface{}) {
+	labels, ok := m["labels"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, l := range systemLabels {
+		delete(labels, l)
+	}
+	if len(labels) == 0 {
+		delete(m, "labels")
+	}
+}
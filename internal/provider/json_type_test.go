@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestKumaJSONSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		desired  string
+		observed string
+		want     bool
+	}{
+		"formatting and ordering": {
+			desired:  `{"type": "Mesh", "name": "m1", "routing": {"zoneEgress": true, "localityAwareLoadBalancing": true}}`,
+			observed: `{"name":"m1","routing":{"localityAwareLoadBalancing":true,"zoneEgress":true},"type":"Mesh"}`,
+			want:     true,
+		},
+		"timestamps, system labels and server defaults": {
+			desired:  `{"type": "MeshTimeout", "name": "mt", "mesh": "default", "labels": {"team": "a"}, "spec": {"targetRef": {"kind": "Mesh"}, "to": [{"targetRef": {"kind": "Mesh"}, "default": {"idleTimeout": "1m"}}]}}`,
+			observed: `{"type": "MeshTimeout", "name": "mt", "mesh": "default", "creationTime": "2024-01-01T00:00:00Z", "modificationTime": "2024-01-01T00:00:00Z", "labels": {"team": "a", "kuma.io/origin": "global", "kuma.io/mesh": "default"}, "spec": {"targetRef": {"kind": "Mesh", "proxyTypes": ["Sidecar"]}, "to": [{"targetRef": {"kind": "Mesh"}, "default": {"idleTimeout": "60s", "connectionTimeout": "5s"}}]}}`,
+			want:     true,
+		},
+		"empty values omitted by the server": {
+			desired:  `{"type": "Mesh", "name": "m1", "labels": {}, "skipCreatingInitialPolicies": []}`,
+			observed: `{"type": "Mesh", "name": "m1", "labels": {"kuma.io/origin": "global"}}`,
+			want:     true,
+		},
+		"changed value": {
+			desired:  `{"type": "Mesh", "name": "m1", "routing": {"zoneEgress": true}}`,
+			observed: `{"type": "Mesh", "name": "m1", "routing": {"zoneEgress": false}}`,
+		},
+		"removed user label": {
+			desired:  `{"type": "Mesh", "name": "m1", "labels": {"team": "a"}}`,
+			observed: `{"type": "Mesh", "name": "m1", "labels": {"kuma.io/origin": "global"}}`,
+		},
+		"extra list item": {
+			desired:  `{"type": "MeshTimeout", "name": "mt", "spec": {"to": [{"targetRef": {"kind": "Mesh"}}]}}`,
+			observed: `{"type": "MeshTimeout", "name": "mt", "spec": {"to": [{"targetRef": {"kind": "Mesh"}}, {"targetRef": {"kind": "MeshService", "name": "a"}}]}}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := NewKumaJSONValue(tt.desired).StringSemanticEquals(context.Background(), NewKumaJSONValue(tt.observed))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("expected %t got %t", tt.want, got)
+			}
+		})
+	}
+}
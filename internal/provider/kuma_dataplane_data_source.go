@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KumaDataplaneDataSource{}
+var _ datasource.DataSourceWithConfigure = &KumaDataplaneDataSource{}
+
+func NewKumaDataplaneDataSource() datasource.DataSource {
+	return &KumaDataplaneDataSource{}
+}
+
+// KumaDataplaneDataSource reads a dataplane and its insight.
+type KumaDataplaneDataSource struct {
+	client kumaapi.Client
+}
+
+// KumaDataplaneDataSourceModel describes the data source data model.
+type KumaDataplaneDataSourceModel struct {
+	Mesh                          types.String        `tfsdk:"mesh"`
+	Name                          types.String        `tfsdk:"name"`
+	Labels                        types.Map           `tfsdk:"labels"`
+	Address                       types.String        `tfsdk:"address"`
+	GatewayType                   types.String        `tfsdk:"gateway_type"`
+	Tags                          types.Map           `tfsdk:"tags"`
+	Inbounds                      []KumaInboundModel  `tfsdk:"inbounds"`
+	Outbounds                     []KumaOutboundModel `tfsdk:"outbounds"`
+	Online                        types.Bool          `tfsdk:"online"`
+	LastConnectTime               types.String        `tfsdk:"last_connect_time"`
+	KumaDpVersion                 types.String        `tfsdk:"kuma_dp_version"`
+	EnvoyVersion                  types.String        `tfsdk:"envoy_version"`
+	MtlsIssuedBackend             types.String        `tfsdk:"mtls_issued_backend"`
+	MtlsCertificateExpirationTime types.String        `tfsdk:"mtls_certificate_expiration_time"`
+}
+
+// KumaInboundModel describes an inbound of a dataplane.
+type KumaInboundModel struct {
+	Port        types.Int64  `tfsdk:"port"`
+	ServicePort types.Int64  `tfsdk:"service_port"`
+	Address     types.String `tfsdk:"address"`
+	Tags        types.Map    `tfsdk:"tags"`
+	Ready       types.Bool   `tfsdk:"ready"`
+}
+
+// KumaOutboundModel describes an outbound of a dataplane.
+type KumaOutboundModel struct {
+	Port    types.Int64  `tfsdk:"port"`
+	Address types.String `tfsdk:"address"`
+	Tags    types.Map    `tfsdk:"tags"`
+}
+
+func (d *KumaDataplaneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataplane"
+}
+
+func (d *KumaDataplaneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := dataplaneDataSourceAttributes()
+	attributes["mesh"] = schema.StringAttribute{
+		MarkdownDescription: "The mesh of the dataplane",
+		Required:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the dataplane",
+		Required:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a dataplane and its connection to the control-plane, e.g. to check that a service is registered before applying policies to it. " +
+			"See the [Dataplane documentation](https://kuma.io/docs/latest/production/dp-config/dpp/).",
+		Attributes: attributes,
+	}
+}
+
+// dataplaneDataSourceAttributes returns the computed attributes of a dataplane, `mesh` and `name` excluded.
+func dataplaneDataSourceAttributes() map[string]schema.Attribute {
+	tags := func(description string) schema.MapAttribute {
+		return schema.MapAttribute{
+			MarkdownDescription: description,
+			ElementType:         types.StringType,
+			Computed:            true,
+		}
+	}
+	return map[string]schema.Attribute{
+		"labels": tags("The labels of the dataplane"),
+		"address": schema.StringAttribute{
+			MarkdownDescription: "The address of the dataplane",
+			Computed:            true,
+		},
+		"gateway_type": schema.StringAttribute{
+			MarkdownDescription: "The type of gateway: `builtin` or `delegated`, unset for sidecars",
+			Computed:            true,
+		},
+		"tags": tags("The tags of the gateway or of the inbounds of a sidecar, the values of a tag differing between inbounds are sorted and joined with `,`"),
+		"inbounds": schema.ListNestedAttribute{
+			MarkdownDescription: "The ports of the dataplane receiving traffic, empty for gateways",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "The port of the dataplane",
+						Computed:            true,
+					},
+					"service_port": schema.Int64Attribute{
+						MarkdownDescription: "The port of the service the traffic is forwarded to",
+						Computed:            true,
+					},
+					"address": schema.StringAttribute{
+						MarkdownDescription: "The address of the inbound, unset when it's the address of the dataplane",
+						Computed:            true,
+					},
+					"tags": tags("The tags of the inbound (e.g. `kuma.io/service`)"),
+					"ready": schema.BoolAttribute{
+						MarkdownDescription: "Whether the inbound receives traffic, false when the service is unhealthy",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"outbounds": schema.ListNestedAttribute{
+			MarkdownDescription: "The outbounds listed in the dataplane, empty when they are generated with transparent proxying",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "The port of the outbound",
+						Computed:            true,
+					},
+					"address": schema.StringAttribute{
+						MarkdownDescription: "The address of the outbound, unset for the default `127.0.0.1`",
+						Computed:            true,
+					},
+					"tags": tags("The tags of the service reached by the outbound"),
+				},
+			},
+		},
+		"online": schema.BoolAttribute{
+			MarkdownDescription: "Whether the dataplane is connected to the control-plane",
+			Computed:            true,
+		},
+		"last_connect_time": schema.StringAttribute{
+			MarkdownDescription: "The time the dataplane last connected to the control-plane, unset if it never connected",
+			Computed:            true,
+		},
+		"kuma_dp_version": schema.StringAttribute{
+			MarkdownDescription: "The version of kuma-dp, unset if it never connected",
+			Computed:            true,
+		},
+		"envoy_version": schema.StringAttribute{
+			MarkdownDescription: "The version of Envoy, unset if it never connected",
+			Computed:            true,
+		},
+		"mtls_issued_backend": schema.StringAttribute{
+			MarkdownDescription: "The mTLS backend which issued the certificate of the dataplane, unset without mTLS",
+			Computed:            true,
+		},
+		"mtls_certificate_expiration_time": schema.StringAttribute{
+			MarkdownDescription: "The time the certificate of the dataplane expires, unset without mTLS",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *KumaDataplaneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, _, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *KumaDataplaneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KumaDataplaneDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dp, err := d.client.DataplaneOverview(ctx, data.Mesh.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Unable to read dataplane", err, func(string) path.Path { return path.Root("name") })...)
+		return
+	}
+	if dp == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "dataplane not found",
+			fmt.Sprintf("Dataplane `%s` doesn't exist in mesh `%s`", data.Name.ValueString(), data.Mesh.ValueString()))
+		return
+	}
+	resp.Diagnostics.Append(data.setDataplane(ctx, *dp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setDataplane stores the dataplane returned by the control-plane in the computed attributes.
+func (m *KumaDataplaneDataSourceModel) setDataplane(ctx context.Context, dp kumaapi.DataplaneOverview) diag.Diagnostics {
+	var diags diag.Diagnostics
+	mapValue := func(v map[string]string) types.Map {
+		if v == nil {
+			v = map[string]string{}
+		}
+		out, d := types.MapValueFrom(ctx, types.StringType, v)
+		diags.Append(d...)
+		return out
+	}
+	networking := dp.Dataplane.Networking
+
+	m.Mesh = types.StringValue(dp.Mesh)
+	m.Name = types.StringValue(dp.Name)
+	m.Labels = mapValue(dp.Labels)
+	m.Address = types.StringValue(networking.Address)
+	m.GatewayType = types.StringNull()
+	if t := dp.GatewayType(); t != "" {
+		m.GatewayType = types.StringValue(t)
+		m.Tags = mapValue(networking.Gateway.Tags)
+	} else {
+		m.Tags = mapValue(mergeTags(networking.Inbound))
+	}
+	m.Inbounds = make([]KumaInboundModel, len(networking.Inbound))
+	for i, in := range networking.Inbound {
+		m.Inbounds[i] = KumaInboundModel{
+			Port:        types.Int64Value(int64(in.Port)),
+			ServicePort: types.Int64Value(int64(in.ServicePort)),
+			Address:     optionalString(in.Address),
+			Tags:        mapValue(in.Tags),
+			Ready:       types.BoolValue(in.Ready()),
+		}
+	}
+	m.Outbounds = make([]KumaOutboundModel, len(networking.Outbound))
+	for i, out := range networking.Outbound {
+		m.Outbounds[i] = KumaOutboundModel{
+			Port:    types.Int64Value(int64(out.Port)),
+			Address: optionalString(out.Address),
+			Tags:    mapValue(out.Tags),
+		}
+	}
+
+	m.Online = types.BoolValue(dp.Online())
+	m.LastConnectTime = types.StringNull()
+	m.KumaDpVersion = types.StringNull()
+	m.EnvoyVersion = types.StringNull()
+	if s := dp.LastSubscription(); s != nil {
+		m.LastConnectTime = timeValue(s.ConnectTime)
+		m.KumaDpVersion = optionalString(s.Version.KumaDp.Version)
+		m.EnvoyVersion = optionalString(s.Version.Envoy.Version)
+	}
+	m.MtlsIssuedBackend = types.StringNull()
+	m.MtlsCertificateExpirationTime = types.StringNull()
+	if mtls := dp.DataplaneInsight.MTLS; mtls != nil {
+		m.MtlsIssuedBackend = optionalString(mtls.IssuedBackend)
+		m.MtlsCertificateExpirationTime = timeValue(mtls.CertificateExpirationTime)
+	}
+	return diags
+}
+
+// mergeTags returns the tags of all the inbounds, the different values of a tag are sorted and joined with `,`.
+func mergeTags(inbounds []kumaapi.DataplaneInbound) map[string]string {
+	values := map[string]map[string]struct{}{}
+	for _, in := range inbounds {
+		for k, v := range in.Tags {
+			if values[k] == nil {
+				values[k] = map[string]struct{}{}
+			}
+			values[k][v] = struct{}{}
+		}
+	}
+	out := map[string]string{}
+	for k, set := range values {
+		var l []string
+		for v := range set {
+			l = append(l, v)
+		}
+		sort.Strings(l)
+		out[k] = strings.Join(l, ",")
+	}
+	return out
+}
+
+// optionalString returns null for empty strings.
+func optionalString(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
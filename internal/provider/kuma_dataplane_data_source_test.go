@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDataplaneDataSourceSetDataplane(t *testing.T) {
+	dp := kumaapi.DataplaneOverview{}
+	err := json.Unmarshal([]byte(`{
+		"mesh": "default", "name": "web-1",
+		"dataplane": {"networking": {
+			"address": "10.0.0.1",
+			"inbound": [
+				{"port": 8080, "servicePort": 80, "tags": {"kuma.io/service": "web", "version": "v1"}, "state": "Ready"},
+				{"port": 8081, "servicePort": 81, "address": "10.0.0.2", "tags": {"kuma.io/service": "web-admin", "version": "v1"}, "state": "NotReady"}
+			]
+		}},
+		"dataplaneInsight": {
+			"subscriptions": [{"connectTime": "2024-01-01T00:00:00Z", "version": {"kumaDp": {"version": "2.7.1"}, "envoy": {"version": "1.29.2"}}}],
+			"mTLS": {"certificateExpirationTime": "2024-01-02T00:00:00Z", "issuedBackend": "ca-1"}
+		}
+	}`), &dp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data KumaDataplaneDataSourceModel
+	if diags := data.setDataplane(context.Background(), dp); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	wantTags, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{"kuma.io/service": "web,web-admin", "version": "v1"})
+	if !data.Tags.Equal(wantTags) {
+		t.Errorf("expected tags %s got %s", wantTags, data.Tags)
+	}
+	if !data.GatewayType.IsNull() || !data.Online.ValueBool() || data.KumaDpVersion.ValueString() != "2.7.1" || data.EnvoyVersion.ValueString() != "1.29.2" {
+		t.Errorf("unexpected dataplane %+v", data)
+	}
+	if data.MtlsIssuedBackend.ValueString() != "ca-1" || data.MtlsCertificateExpirationTime.ValueString() != "2024-01-02T00:00:00Z" {
+		t.Errorf("unexpected mtls %s %s", data.MtlsIssuedBackend, data.MtlsCertificateExpirationTime)
+	}
+	if len(data.Inbounds) != 2 || !data.Inbounds[0].Ready.ValueBool() || data.Inbounds[1].Ready.ValueBool() ||
+		!data.Inbounds[0].Address.IsNull() || data.Inbounds[1].Address.ValueString() != "10.0.0.2" {
+		t.Errorf("unexpected inbounds %+v", data.Inbounds)
+	}
+	if len(data.Outbounds) != 0 {
+		t.Errorf("unexpected outbounds %+v", data.Outbounds)
+	}
+}
+
+func TestMergeTags(t *testing.T) {
+	got := mergeTags([]kumaapi.DataplaneInbound{
+		{Tags: map[string]string{"kuma.io/service": "web", "team": "core"}},
+		{Tags: map[string]string{"kuma.io/service": "admin", "team": "core"}},
+	})
+	want := map[string]string{"kuma.io/service": "admin,web", "team": "core"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected tags (-want +got):\n%s", diff)
+	}
+}
+
+func TestAccDataplanesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: localProviderConfig + `
+resource "kuma_raw_resource" "dataplane" {
+  raw_json = jsonencode({
+    type = "Dataplane"
+    name = "tf-ds-dp"
+    mesh = "default"
+    networking = {
+      address = "127.0.0.1"
+      inbound = [{
+        port        = 8080
+        servicePort = 80
+        tags        = { "kuma.io/service" = "tf-ds-web" }
+      }]
+    }
+  })
+}
+
+data "kuma_dataplane" "dp" {
+  mesh = "default"
+  name = kuma_raw_resource.dataplane.name
+}
+
+data "kuma_dataplanes" "web" {
+  mesh       = "default"
+  tags       = { "kuma.io/service" = "tf-ds-web" }
+  kind       = "sidecar"
+  online     = false
+  depends_on = [kuma_raw_resource.dataplane]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kuma_dataplane.dp", "online", "false"),
+					resource.TestCheckResourceAttr("data.kuma_dataplane.dp", "inbounds.0.tags.kuma.io/service", "tf-ds-web"),
+					resource.TestCheckResourceAttr("data.kuma_dataplanes.web", "items.#", "1"),
+					resource.TestCheckResourceAttr("data.kuma_dataplanes.web", "items.0.name", "tf-ds-dp"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dataplaneTokenTypes are the types of proxies accepted by `/tokens/dataplane`.
+var dataplaneTokenTypes = []string{"dataplane", "ingress"}
+
+// dataplaneToken generates the tokens used by kuma-dp, like `kumactl generate dataplane-token`.
+var dataplaneToken = tokenDefinition{
+	typeName:            "dataplane_token",
+	markdownDescription: "Generates a token used by kuma-dp to connect to the control-plane in universal mode, like `kumactl generate dataplane-token`.",
+	attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the dataplane allowed to use the token, any name is allowed when unset",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"mesh": schema.StringAttribute{
+			MarkdownDescription: "Mesh of the dataplane",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"tags": schema.MapAttribute{
+			MarkdownDescription: "Tags the dataplane must have to use the token, e.g. `{ \"kuma.io/service\" = [\"web\"] }`",
+			ElementType:         types.ListType{ElemType: types.StringType},
+			Optional:            true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of the proxy, one of `dataplane` and `ingress`, defaults to `dataplane`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(dataplaneTokenTypes...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	},
+	ephemeralAttributes: map[string]ephemeralschema.Attribute{
+		"name": ephemeralschema.StringAttribute{
+			MarkdownDescription: "Name of the dataplane allowed to use the token, any name is allowed when unset",
+			Optional:            true,
+		},
+		"mesh": ephemeralschema.StringAttribute{
+			MarkdownDescription: "Mesh of the dataplane",
+			Required:            true,
+		},
+		"tags": ephemeralschema.MapAttribute{
+			MarkdownDescription: "Tags the dataplane must have to use the token, e.g. `{ \"kuma.io/service\" = [\"web\"] }`",
+			ElementType:         types.ListType{ElemType: types.StringType},
+			Optional:            true,
+		},
+		"type": ephemeralschema.StringAttribute{
+			MarkdownDescription: "Type of the proxy, one of `dataplane` and `ingress`, defaults to `dataplane`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(dataplaneTokenTypes...),
+			},
+		},
+	},
+	generate: func(ctx context.Context, client kumaapi.Client, data tokenRequestData) (string, diag.Diagnostics) {
+		var name, mesh, proxyType, validFor types.String
+		var tags types.Map
+		var diags diag.Diagnostics
+		diags.Append(data.GetAttribute(ctx, path.Root("name"), &name)...)
+		diags.Append(data.GetAttribute(ctx, path.Root("mesh"), &mesh)...)
+		diags.Append(data.GetAttribute(ctx, path.Root("tags"), &tags)...)
+		diags.Append(data.GetAttribute(ctx, path.Root("type"), &proxyType)...)
+		diags.Append(data.GetAttribute(ctx, path.Root("valid_for"), &validFor)...)
+		if diags.HasError() {
+			return "", diags
+		}
+		return generateDataplaneToken(ctx, client, name, mesh, tags, proxyType, validFor)
+	},
+}
+
+func NewKumaDataplaneTokenResource() resource.Resource {
+	return newKumaTokenResource(dataplaneToken)()
+}
+
+func NewKumaDataplaneTokenEphemeralResource() ephemeral.EphemeralResource {
+	return newKumaTokenEphemeralResource(dataplaneToken)()
+}
+
+// generateDataplaneToken requests a dataplane token from the control-plane.
+func generateDataplaneToken(ctx context.Context, client kumaapi.Client, name types.String, mesh types.String, tags types.Map, proxyType types.String, validFor types.String) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := kumaapi.DataplaneTokenRequest{
+		Name:     name.ValueString(),
+		Mesh:     mesh.ValueString(),
+		Type:     proxyType.ValueString(),
+		ValidFor: validFor.ValueString(),
+	}
+	if !tags.IsNull() {
+		diags.Append(tags.ElementsAs(ctx, &req.Tags, false)...)
+	}
+	if diags.HasError() {
+		return "", diags
+	}
+	token, err := client.GenerateDataplaneToken(ctx, req)
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Unable to generate dataplane token", err, tokenFieldPath)...)
+		return "", diags
+	}
+	return token, diags
+}
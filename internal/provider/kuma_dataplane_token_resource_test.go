@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestTokenFieldPath(t *testing.T) {
+	tests := map[string]path.Path{
+		"validFor": path.Root("valid_for"),
+		"mesh":     path.Root("mesh"),
+		"":         path.Empty(),
+	}
+	for field, want := range tests {
+		if got := tokenFieldPath(field); !got.Equal(want) {
+			t.Errorf("expected %s for %q got %s", want, field, got)
+		}
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	tests := map[string]struct {
+		value     types.String
+		wantError bool
+	}{
+		"valid":    {value: types.StringValue("720h")},
+		"null":     {value: types.StringNull()},
+		"unknown":  {value: types.StringUnknown()},
+		"invalid":  {value: types.StringValue("a month"), wantError: true},
+		"negative": {value: types.StringValue("-1h"), wantError: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("valid_for"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+			durationValidator{}.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("unexpected diagnostics %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestAccDataplaneTokenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: localProviderConfig + `
+resource "kuma_dataplane_token" "web" {
+  mesh      = "default"
+  name      = "tf-token-web"
+  tags      = { "kuma.io/service" = ["tf-token-web"] }
+  valid_for = "24h"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("kuma_dataplane_token.web", "token"),
+					resource.TestCheckResourceAttrSet("kuma_dataplane_token.web", "expires_at"),
+				),
+			},
+			{
+				// The token expires within renew_before, the plan after the apply generates a new one.
+				Config: localProviderConfig + `
+resource "kuma_dataplane_token" "web" {
+  mesh         = "default"
+  name         = "tf-token-web"
+  tags         = { "kuma.io/service" = ["tf-token-web"] }
+  valid_for    = "24h"
+  renew_before = "48h"
+}
+`,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: localProviderConfig + `
+resource "kuma_dataplane_token" "web" {
+  mesh      = "default"
+  valid_for = "a day"
+}
+`,
+				ExpectError: regexp.MustCompile("not a valid duration"),
+			},
+		},
+	})
+}
+
+func TestAccDataplaneTokenEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"kuma": testAccProtoV6ProviderFactories["kuma"],
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: localProviderConfig + `
+ephemeral "kuma_dataplane_token" "web" {
+  mesh      = "default"
+  tags      = { "kuma.io/service" = ["tf-token-web"] }
+  valid_for = "1h"
+}
+
+provider "echo" {
+  data = ephemeral.kuma_dataplane_token.web
+}
+
+resource "echo" "token" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.token", "data.token"),
+					resource.TestCheckResourceAttrSet("echo.token", "data.expires_at"),
+				),
+			},
+		},
+	})
+}
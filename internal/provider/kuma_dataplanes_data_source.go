@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KumaDataplanesDataSource{}
+var _ datasource.DataSourceWithConfigure = &KumaDataplanesDataSource{}
+
+func NewKumaDataplanesDataSource() datasource.DataSource {
+	return &KumaDataplanesDataSource{}
+}
+
+// KumaDataplanesDataSource lists the dataplanes and their insight.
+type KumaDataplanesDataSource struct {
+	client kumaapi.Client
+}
+
+// KumaDataplanesDataSourceModel describes the data source data model.
+type KumaDataplanesDataSourceModel struct {
+	Mesh         types.String                   `tfsdk:"mesh"`
+	Tags         types.Map                      `tfsdk:"tags"`
+	Kind         types.String                   `tfsdk:"kind"`
+	Online       types.Bool                     `tfsdk:"online"`
+	NameContains types.String                   `tfsdk:"name_contains"`
+	Labels       types.Map                      `tfsdk:"labels"`
+	PageSize     types.Int64                    `tfsdk:"page_size"`
+	Items        []KumaDataplaneDataSourceModel `tfsdk:"items"`
+}
+
+// dataplaneKinds are the values of `kind` and the matching `gateway` query parameter of the control-plane.
+var dataplaneKinds = map[string]string{
+	"sidecar":           "false",
+	"gateway":           "true",
+	"builtin_gateway":   "builtin",
+	"delegated_gateway": "delegated",
+}
+
+// dataplaneKind returns the `kind` of a dataplane from its gateway type (see DataplaneOverview.GatewayType).
+func dataplaneKind(gatewayType string) string {
+	if gatewayType == "" {
+		return "sidecar"
+	}
+	return gatewayType + "_gateway"
+}
+
+func (d *KumaDataplanesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataplanes"
+}
+
+func (d *KumaDataplanesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	item := dataplaneDataSourceAttributes()
+	item["mesh"] = schema.StringAttribute{
+		MarkdownDescription: "The mesh of the dataplane",
+		Computed:            true,
+	}
+	item["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the dataplane",
+		Computed:            true,
+	}
+	attributes := listFilterAttributes("dataplanes")
+	attributes["mesh"] = schema.StringAttribute{
+		MarkdownDescription: "The mesh to list the dataplanes of, the dataplanes of all meshes are listed when unset",
+		Optional:            true,
+	}
+	attributes["tags"] = schema.MapAttribute{
+		MarkdownDescription: "Only list the dataplanes having all these tags (e.g. `{\"kuma.io/service\" = \"web\"}`)",
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+	attributes["kind"] = schema.StringAttribute{
+		MarkdownDescription: "Only list the `sidecar`, `gateway`, `builtin_gateway` or `delegated_gateway` dataplanes",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("sidecar", "gateway", "builtin_gateway", "delegated_gateway"),
+		},
+	}
+	attributes["online"] = schema.BoolAttribute{
+		MarkdownDescription: "Only list the dataplanes connected (`true`) or not connected (`false`) to the control-plane",
+		Optional:            true,
+	}
+	attributes["items"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The dataplanes, with the same attributes as the `kuma_dataplane` data source",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: item,
+		},
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the dataplanes and their connection to the control-plane, e.g. to check that the instances of a service are registered before applying policies to it.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *KumaDataplanesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, _, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *KumaDataplanesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KumaDataplanesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters, diags := listFilters(ctx, data.NameContains, data.Labels, data.PageSize)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &filters.Tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	filters.Gateway = dataplaneKinds[data.Kind.ValueString()]
+	dataplanes, err := d.client.ListDataplaneOverviews(ctx, data.Mesh.ValueString(), filters)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Unable to list dataplanes", err, func(string) path.Path { return path.Root("mesh") })...)
+		return
+	}
+	data.Items = []KumaDataplaneDataSourceModel{}
+	for _, dp := range dataplanes {
+		// The control-plane can't filter on the connection status.
+		if !data.Online.IsNull() && dp.Online() != data.Online.ValueBool() {
+			continue
+		}
+		var item KumaDataplaneDataSourceModel
+		resp.Diagnostics.Append(item.setDataplane(ctx, dp)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Items = append(data.Items, item)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
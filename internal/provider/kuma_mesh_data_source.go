@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KumaMeshDataSource{}
+var _ datasource.DataSourceWithConfigure = &KumaMeshDataSource{}
+
+func NewKumaMeshDataSource() datasource.DataSource {
+	return &KumaMeshDataSource{}
+}
+
+// KumaMeshDataSource reads a mesh.
+type KumaMeshDataSource struct {
+	client   kumaapi.Client
+	metadata kumaapi.Metadata
+}
+
+// KumaMeshDataSourceModel describes the data source data model.
+type KumaMeshDataSourceModel struct {
+	Name                  types.String `tfsdk:"name"`
+	Labels                types.Map    `tfsdk:"labels"`
+	MtlsEnabledBackend    types.String `tfsdk:"mtls_enabled_backend"`
+	MtlsBackendType       types.String `tfsdk:"mtls_backend_type"`
+	MeshServicesMode      types.String `tfsdk:"mesh_services_mode"`
+	MeshServicesExclusive types.Bool   `tfsdk:"mesh_services_exclusive"`
+	RawJson               types.String `tfsdk:"raw_json"`
+	CreationTime          types.String `tfsdk:"creation_time"`
+	ModificationTime      types.String `tfsdk:"modification_time"`
+}
+
+func (d *KumaMeshDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mesh"
+}
+
+func (d *KumaMeshDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := meshDataSourceAttributes()
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the mesh",
+		Required:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a mesh, see the [Mesh documentation](https://kuma.io/docs/latest/production/mesh/).",
+		Attributes:          attributes,
+	}
+}
+
+// meshDataSourceAttributes returns the computed attributes of a mesh, `name` excluded.
+func meshDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "The labels of the mesh",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"mtls_enabled_backend": schema.StringAttribute{
+			MarkdownDescription: "The name of the enabled mTLS backend, unset when mTLS is disabled",
+			Computed:            true,
+		},
+		"mtls_backend_type": schema.StringAttribute{
+			MarkdownDescription: "The type of the enabled mTLS backend (e.g. `builtin`, `provided` or `vault`), unset when mTLS is disabled",
+			Computed:            true,
+		},
+		"mesh_services_mode": schema.StringAttribute{
+			MarkdownDescription: "How MeshServices are used in the mesh: `Disabled`, `Everywhere`, `ReachableBackends` or `Exclusive`",
+			Computed:            true,
+		},
+		"mesh_services_exclusive": schema.BoolAttribute{
+			MarkdownDescription: "Whether MeshServices are the only way to reference services in the mesh (`kuma.io/service` tags aren't used)",
+			Computed:            true,
+		},
+		"raw_json": schema.StringAttribute{
+			MarkdownDescription: "The mesh as returned by the control-plane, without its timestamps. Use `jsondecode` to access its other fields",
+			Computed:            true,
+		},
+		"creation_time": schema.StringAttribute{
+			MarkdownDescription: "The time the mesh was created in the control-plane",
+			Computed:            true,
+		},
+		"modification_time": schema.StringAttribute{
+			MarkdownDescription: "The time the mesh was last modified in the control-plane",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *KumaMeshDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, metadata, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = client
+	d.metadata = metadata
+}
+
+func (d *KumaMeshDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KumaMeshDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourcePath, _, diags := resolveResource(d.metadata, "Mesh", "")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	res, err := d.client.FetchResource(ctx, "", resourcePath, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Unable to read mesh", err, func(string) path.Path { return path.Root("name") })...)
+		return
+	}
+	if res == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "mesh not found", fmt.Sprintf("Mesh `%s` doesn't exist", data.Name.ValueString()))
+		return
+	}
+	resp.Diagnostics.Append(data.setMesh(ctx, res)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// meshSummary holds the fields of a mesh exposed as attributes.
+type meshSummary struct {
+	Name string `json:"name"`
+	Mtls *struct {
+		EnabledBackend string `json:"enabledBackend"`
+		Backends       []struct {
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"backends"`
+	} `json:"mtls"`
+	MeshServices *struct {
+		Mode string `json:"mode"`
+		// Enabled is the name of `mode` before Kuma 2.9.
+		Enabled string `json:"enabled"`
+	} `json:"meshServices"`
+}
+
+// setMesh stores the mesh returned by the control-plane in the computed attributes.
+func (m *KumaMeshDataSourceModel) setMesh(ctx context.Context, res []byte) diag.Diagnostics {
+	var r KumaResourceDataSourceModel
+	diags := r.setResource(ctx, res)
+	if diags.HasError() {
+		return diags
+	}
+	m.RawJson, m.Labels, m.CreationTime, m.ModificationTime = r.RawJson, r.Labels, r.CreationTime, r.ModificationTime
+
+	mesh := meshSummary{}
+	if err := json.Unmarshal(res, &mesh); err != nil {
+		diags.AddError("client Error", fmt.Sprintf("Failed to decode mesh, got error: %s", err))
+		return diags
+	}
+	m.Name = types.StringValue(mesh.Name)
+	m.MtlsEnabledBackend = types.StringNull()
+	m.MtlsBackendType = types.StringNull()
+	if mesh.Mtls != nil && mesh.Mtls.EnabledBackend != "" {
+		m.MtlsEnabledBackend = types.StringValue(mesh.Mtls.EnabledBackend)
+		for _, b := range mesh.Mtls.Backends {
+			if b.Name == mesh.Mtls.EnabledBackend {
+				m.MtlsBackendType = types.StringValue(b.Type)
+			}
+		}
+	}
+	mode := "Disabled"
+	if mesh.MeshServices != nil {
+		switch {
+		case mesh.MeshServices.Mode != "":
+			mode = mesh.MeshServices.Mode
+		case mesh.MeshServices.Enabled != "":
+			mode = mesh.MeshServices.Enabled
+		}
+	}
+	m.MeshServicesMode = types.StringValue(mode)
+	m.MeshServicesExclusive = types.BoolValue(mode == "Exclusive")
+	return diags
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMeshDataSourceSetMesh(t *testing.T) {
+	tests := map[string]struct {
+		mesh                  string
+		mtlsEnabledBackend    types.String
+		mtlsBackendType       types.String
+		meshServicesMode      string
+		meshServicesExclusive bool
+	}{
+		"defaults": {
+			mesh:               `{"type": "Mesh", "name": "default"}`,
+			mtlsEnabledBackend: types.StringNull(),
+			mtlsBackendType:    types.StringNull(),
+			meshServicesMode:   "Disabled",
+		},
+		"mtls and exclusive mesh services": {
+			mesh:                  `{"type": "Mesh", "name": "default", "mtls": {"enabledBackend": "ca-2", "backends": [{"name": "ca-1", "type": "builtin"}, {"name": "ca-2", "type": "provided"}]}, "meshServices": {"mode": "Exclusive"}}`,
+			mtlsEnabledBackend:    types.StringValue("ca-2"),
+			mtlsBackendType:       types.StringValue("provided"),
+			meshServicesMode:      "Exclusive",
+			meshServicesExclusive: true,
+		},
+		"mtls backend not enabled": {
+			mesh:               `{"type": "Mesh", "name": "default", "mtls": {"backends": [{"name": "ca-1", "type": "builtin"}]}, "meshServices": {"mode": "Everywhere"}}`,
+			mtlsEnabledBackend: types.StringNull(),
+			mtlsBackendType:    types.StringNull(),
+			meshServicesMode:   "Everywhere",
+		},
+		"mesh services before 2.9": {
+			mesh:                  `{"type": "Mesh", "name": "default", "meshServices": {"enabled": "Exclusive"}}`,
+			mtlsEnabledBackend:    types.StringNull(),
+			mtlsBackendType:       types.StringNull(),
+			meshServicesMode:      "Exclusive",
+			meshServicesExclusive: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var data KumaMeshDataSourceModel
+			if diags := data.setMesh(context.Background(), []byte(tt.mesh)); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if data.Name.ValueString() != "default" {
+				t.Errorf("unexpected name %s", data.Name)
+			}
+			if !data.MtlsEnabledBackend.Equal(tt.mtlsEnabledBackend) || !data.MtlsBackendType.Equal(tt.mtlsBackendType) {
+				t.Errorf("expected mtls %s/%s got %s/%s", tt.mtlsEnabledBackend, tt.mtlsBackendType, data.MtlsEnabledBackend, data.MtlsBackendType)
+			}
+			if data.MeshServicesMode.ValueString() != tt.meshServicesMode || data.MeshServicesExclusive.ValueBool() != tt.meshServicesExclusive {
+				t.Errorf("expected mesh services %s/%t got %s/%s", tt.meshServicesMode, tt.meshServicesExclusive, data.MeshServicesMode, data.MeshServicesExclusive)
+			}
+		})
+	}
+}
+
+func TestAccMeshDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: localProviderConfig + `
+resource "kuma_raw_resource" "mesh" {
+  raw_json = jsonencode({
+    type   = "Mesh"
+    name   = "tf-ds-mesh"
+    labels = { team = "tf-ds" }
+    mtls = {
+      enabledBackend = "ca-1"
+      backends       = [{ name = "ca-1", type = "builtin" }]
+    }
+  })
+}
+
+data "kuma_mesh" "mesh" {
+  name = kuma_raw_resource.mesh.name
+}
+
+data "kuma_meshes" "meshes" {
+  labels     = { team = "tf-ds" }
+  depends_on = [kuma_raw_resource.mesh]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kuma_mesh.mesh", "mtls_enabled_backend", "ca-1"),
+					resource.TestCheckResourceAttr("data.kuma_mesh.mesh", "mtls_backend_type", "builtin"),
+					resource.TestCheckResourceAttr("data.kuma_meshes.meshes", "items.#", "1"),
+					resource.TestCheckResourceAttr("data.kuma_meshes.meshes", "items.0.name", "tf-ds-mesh"),
+				),
+			},
+		},
+	})
+}
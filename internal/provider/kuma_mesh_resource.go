@@ -0,0 +1,530 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// mtlsBackendTypes are the types of CA backends, each one has its own attribute holding its `conf`.
+var mtlsBackendTypes = []string{"builtin", "provided", "vault", "acmpca", "certmanager"}
+
+func NewKumaMeshResource() resource.Resource {
+	return newKumaTypedResource(typedResourceDefinition{
+		typeName:            "mesh",
+		kumaType:            "Mesh",
+		markdownDescription: "A Kuma mesh, see the [Mesh documentation](https://kuma.io/docs/latest/production/mesh/).",
+		attributes:          meshAttributes(),
+		jsonNames: map[string]string{
+			"rsa_bits": "RSAbits",
+		},
+		toKuma:   meshToKuma,
+		fromKuma: meshFromKuma,
+	})()
+}
+
+// meshToKuma moves the typed conf of each mtls backend to `type` and `conf`.
+func meshToKuma(m map[string]interface{}) {
+	for _, backend := range mtlsBackends(m) {
+		for _, t := range mtlsBackendTypes {
+			if conf, ok := backend[t]; ok {
+				backend["type"] = t
+				backend["conf"] = conf
+				delete(backend, t)
+			}
+		}
+	}
+}
+
+// meshFromKuma moves the `conf` of each mtls backend to the attribute named after its `type`. Backends of other types
+// can't be represented, dropping them would overwrite their configuration on the next apply.
+func meshFromKuma(m map[string]interface{}) error {
+	for _, backend := range mtlsBackends(m) {
+		t, _ := backend["type"].(string)
+		if !slices.Contains(mtlsBackendTypes, t) {
+			return fmt.Errorf("the mtls backend `%v` has the type `%s` which isn't one of `%s`, manage this mesh with kuma_raw_resource",
+				backend["name"], t, strings.Join(mtlsBackendTypes, "`, `"))
+		}
+		conf, ok := backend["conf"]
+		if !ok {
+			conf = map[string]interface{}{}
+		}
+		backend[t] = conf
+		delete(backend, "conf")
+		delete(backend, "type")
+	}
+	return nil
+}
+
+func mtlsBackends(m map[string]interface{}) []map[string]interface{} {
+	mtls, _ := m["mtls"].(map[string]interface{})
+	backends, _ := mtls["backends"].([]interface{})
+	var out []map[string]interface{}
+	for _, b := range backends {
+		if backend, ok := b.(map[string]interface{}); ok {
+			out = append(out, backend)
+		}
+	}
+	return out
+}
+
+func meshAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"skip_creating_initial_policies": schema.ListAttribute{
+			MarkdownDescription: "List of policies to skip creating by default when the mesh is created, `*` skips all of them",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"mesh_services": schema.SingleNestedAttribute{
+			MarkdownDescription: "MeshServices settings of the mesh",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"mode": schema.StringAttribute{
+					MarkdownDescription: "How MeshServices are used in the mesh, one of `Disabled`, `Everywhere`, `ReachableBackends` or `Exclusive`",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("Disabled", "Everywhere", "ReachableBackends", "Exclusive"),
+					},
+				},
+			},
+		},
+		"mtls": schema.SingleNestedAttribute{
+			MarkdownDescription: "mTLS settings of the mesh",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"enabled_backend": schema.StringAttribute{
+					MarkdownDescription: "Name of the backend used to issue certificates, mTLS is disabled when unset",
+					Optional:            true,
+				},
+				"skip_validation": schema.BoolAttribute{
+					MarkdownDescription: "Skip the validation of the CA backends",
+					Optional:            true,
+				},
+				"backends": schema.ListNestedAttribute{
+					MarkdownDescription: "CA backends, exactly one of `builtin`, `provided`, `vault`, `acmpca` or `certmanager` must be set on each",
+					Optional:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: mtlsBackendAttributes(),
+					},
+				},
+			},
+		},
+		"networking": schema.SingleNestedAttribute{
+			MarkdownDescription: "Networking settings of the mesh",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"outbound": schema.SingleNestedAttribute{
+					MarkdownDescription: "Outbound settings",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"passthrough": schema.BoolAttribute{
+							MarkdownDescription: "Whether traffic to destinations outside of the mesh is allowed",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+		"routing": schema.SingleNestedAttribute{
+			MarkdownDescription: "Routing settings of the mesh",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"locality_aware_load_balancing": schema.BoolAttribute{
+					MarkdownDescription: "Enable locality aware load balancing",
+					Optional:            true,
+				},
+				"zone_egress": schema.BoolAttribute{
+					MarkdownDescription: "Route cross zone and external traffic through ZoneEgress",
+					Optional:            true,
+				},
+				"default_forbid_mesh_external_service_access": schema.BoolAttribute{
+					MarkdownDescription: "Forbid access to MeshExternalServices unless allowed by a MeshTrafficPermission",
+					Optional:            true,
+				},
+			},
+		},
+		"constraints": schema.SingleNestedAttribute{
+			MarkdownDescription: "Constraints on the dataplanes allowed to join the mesh",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"dataplane_proxy": schema.SingleNestedAttribute{
+					MarkdownDescription: "Constraints on dataplane proxies",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"requirements": tagSelectorsAttribute("Dataplanes must match at least one of these selectors"),
+						"restrictions": tagSelectorsAttribute("Dataplanes must not match any of these selectors"),
+					},
+				},
+			},
+		},
+		"logging": schema.SingleNestedAttribute{
+			MarkdownDescription: "Logging backends of the mesh",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"default_backend": schema.StringAttribute{
+					MarkdownDescription: "Name of the backend used by default",
+					Optional:            true,
+				},
+				"backends": meshBackendsAttribute("Logging backends", []string{"file", "tcp"}, map[string]schema.Attribute{
+					"format": schema.StringAttribute{
+						MarkdownDescription: "Format of the access logs",
+						Optional:            true,
+					},
+				}),
+			},
+		},
+		"tracing": schema.SingleNestedAttribute{
+			MarkdownDescription: "Tracing backends of the mesh",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"default_backend": schema.StringAttribute{
+					MarkdownDescription: "Name of the backend used by default",
+					Optional:            true,
+				},
+				"backends": meshBackendsAttribute("Tracing backends", []string{"zipkin", "datadog"}, map[string]schema.Attribute{
+					"sampling": schema.Float64Attribute{
+						MarkdownDescription: "Percentage of traces to sample",
+						Optional:            true,
+					},
+				}),
+			},
+		},
+		"metrics": schema.SingleNestedAttribute{
+			MarkdownDescription: "Metrics backends of the mesh",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"enabled_backend": schema.StringAttribute{
+					MarkdownDescription: "Name of the backend used to expose metrics",
+					Optional:            true,
+				},
+				"backends": meshBackendsAttribute("Metrics backends", []string{"prometheus"}, nil),
+			},
+		},
+	}
+}
+
+func mtlsBackendAttributes() map[string]schema.Attribute {
+	otherTypes := func(current string) []path.Expression {
+		var out []path.Expression
+		for _, t := range mtlsBackendTypes {
+			if t != current {
+				out = append(out, path.MatchRelative().AtParent().AtName(t))
+			}
+		}
+		return out
+	}
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the backend",
+			Required:            true,
+		},
+		"mode": schema.StringAttribute{
+			MarkdownDescription: "Mode of mTLS, one of `STRICT` or `PERMISSIVE`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("STRICT", "PERMISSIVE"),
+			},
+		},
+		"dp_cert": schema.SingleNestedAttribute{
+			MarkdownDescription: "Settings of the certificates issued to dataplanes",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"rotation": schema.SingleNestedAttribute{
+					MarkdownDescription: "Rotation settings",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"expiration": schema.StringAttribute{
+							MarkdownDescription: "Time after which certificates expire (e.g. `1d`)",
+							Optional:            true,
+						},
+					},
+				},
+				"request_timeout": schema.StringAttribute{
+					MarkdownDescription: "Timeout of the certificate requests (e.g. `10s`)",
+					Optional:            true,
+				},
+			},
+		},
+		"root_chain": schema.SingleNestedAttribute{
+			MarkdownDescription: "Settings of the root chain requests",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"request_timeout": schema.StringAttribute{
+					MarkdownDescription: "Timeout of the root chain requests (e.g. `10s`)",
+					Optional:            true,
+				},
+			},
+		},
+		"builtin": schema.SingleNestedAttribute{
+			MarkdownDescription: "A CA generated and stored by the control-plane",
+			Optional:            true,
+			Validators: []validator.Object{
+				objectvalidator.ExactlyOneOf(otherTypes("builtin")...),
+			},
+			Attributes: map[string]schema.Attribute{
+				"ca_cert": schema.SingleNestedAttribute{
+					MarkdownDescription: "Settings of the generated CA",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"rsa_bits": schema.Int64Attribute{
+							MarkdownDescription: "Size of the RSA key",
+							Optional:            true,
+						},
+						"expiration": schema.StringAttribute{
+							MarkdownDescription: "Time after which the CA expires (e.g. `10y`)",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+		"provided": schema.SingleNestedAttribute{
+			MarkdownDescription: "A CA provided by the user",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"cert": dataSourceAttribute("The CA certificate"),
+				"key":  dataSourceAttribute("The CA key"),
+			},
+		},
+		"vault": schema.SingleNestedAttribute{
+			MarkdownDescription: "A CA stored in Vault (Kong Mesh only)",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"from_cp": schema.SingleNestedAttribute{
+					MarkdownDescription: "Settings used by the control-plane to reach Vault",
+					Required:            true,
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							MarkdownDescription: "Address of Vault",
+							Required:            true,
+						},
+						"agent_address": schema.StringAttribute{
+							MarkdownDescription: "Address of the Vault agent",
+							Optional:            true,
+						},
+						"namespace": schema.StringAttribute{
+							MarkdownDescription: "Vault namespace",
+							Optional:            true,
+						},
+						"pki": schema.StringAttribute{
+							MarkdownDescription: "Path of the PKI secrets engine",
+							Required:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role used to issue certificates",
+							Required:            true,
+						},
+						"common_name": schema.StringAttribute{
+							MarkdownDescription: "Template of the common name of the certificates",
+							Optional:            true,
+						},
+						"tls": schema.SingleNestedAttribute{
+							MarkdownDescription: "TLS settings to reach Vault",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"ca_cert": dataSourceAttribute("CA used to verify Vault"),
+								"skip_verify": schema.BoolAttribute{
+									MarkdownDescription: "Skip the verification of the Vault certificate",
+									Optional:            true,
+								},
+								"server_name": schema.StringAttribute{
+									MarkdownDescription: "Server name used to verify the Vault certificate",
+									Optional:            true,
+								},
+							},
+						},
+						"auth": schema.SingleNestedAttribute{
+							MarkdownDescription: "How the control-plane authenticates to Vault",
+							Required:            true,
+							Attributes: map[string]schema.Attribute{
+								"token": dataSourceAttribute("Vault token"),
+								"tls": schema.SingleNestedAttribute{
+									MarkdownDescription: "Client certificate authentication",
+									Optional:            true,
+									Attributes: map[string]schema.Attribute{
+										"client_cert": dataSourceAttribute("Client certificate"),
+										"client_key":  dataSourceAttribute("Client key"),
+									},
+								},
+								"aws": schema.SingleNestedAttribute{
+									MarkdownDescription: "AWS authentication",
+									Optional:            true,
+									Attributes: map[string]schema.Attribute{
+										"type": schema.StringAttribute{
+											MarkdownDescription: "Type of the AWS authentication, `iam` or `ec2`",
+											Optional:            true,
+										},
+										"iam_server_id_header": schema.StringAttribute{
+											MarkdownDescription: "Value of the `X-Vault-AWS-IAM-Server-ID` header",
+											Optional:            true,
+										},
+										"role": schema.StringAttribute{
+											MarkdownDescription: "Vault role to authenticate with",
+											Optional:            true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"acmpca": schema.SingleNestedAttribute{
+			MarkdownDescription: "A CA managed by AWS Certificate Manager Private CA (Kong Mesh only)",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"arn": schema.StringAttribute{
+					MarkdownDescription: "ARN of the private CA",
+					Required:            true,
+				},
+				"common_name": schema.StringAttribute{
+					MarkdownDescription: "Template of the common name of the certificates",
+					Optional:            true,
+				},
+				"ca_cert": dataSourceAttribute("The root certificate of the private CA"),
+				"auth": schema.SingleNestedAttribute{
+					MarkdownDescription: "How the control-plane authenticates to AWS",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"aws_credentials": schema.SingleNestedAttribute{
+							MarkdownDescription: "Static AWS credentials",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"access_key":        dataSourceAttribute("AWS access key"),
+								"access_key_secret": dataSourceAttribute("AWS secret access key"),
+							},
+						},
+					},
+				},
+			},
+		},
+		"certmanager": schema.SingleNestedAttribute{
+			MarkdownDescription: "A CA managed by cert-manager on Kubernetes (Kong Mesh only)",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"issuer_ref": schema.SingleNestedAttribute{
+					MarkdownDescription: "Reference to the cert-manager issuer",
+					Required:            true,
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the issuer",
+							Required:            true,
+						},
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of the issuer (e.g. `ClusterIssuer`)",
+							Optional:            true,
+						},
+						"group": schema.StringAttribute{
+							MarkdownDescription: "Group of the issuer",
+							Optional:            true,
+						},
+					},
+				},
+				"common_name": schema.StringAttribute{
+					MarkdownDescription: "Template of the common name of the certificates",
+					Optional:            true,
+				},
+				"ca_cert": dataSourceAttribute("The root certificate of the issuer"),
+				"dns_names": schema.ListAttribute{
+					MarkdownDescription: "DNS names to add to the certificates",
+					ElementType:         types.StringType,
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
+// dataSourceAttribute is the kuma way to reference a value, exactly one of its attributes must be set.
+func dataSourceAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description + ", exactly one of `secret`, `file`, `inline` or `inline_string` must be set",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "Name of a Kuma secret",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRelative().AtParent().AtName("file"),
+						path.MatchRelative().AtParent().AtName("inline"),
+						path.MatchRelative().AtParent().AtName("inline_string"),
+					),
+				},
+			},
+			"file": schema.StringAttribute{
+				MarkdownDescription: "Path of a file on the control-plane",
+				Optional:            true,
+			},
+			"inline": schema.StringAttribute{
+				MarkdownDescription: "Base64 encoded value",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"inline_string": schema.StringAttribute{
+				MarkdownDescription: "Value as a string",
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func tagSelectorsAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"tags": schema.MapAttribute{
+					MarkdownDescription: "Tags to match",
+					ElementType:         types.StringType,
+					Required:            true,
+				},
+			},
+		},
+	}
+}
+
+// meshBackendsAttribute describes logging, tracing and metrics backends whose `conf` depends on their type.
+func meshBackendsAttribute(description string, backendTypes []string, extra map[string]schema.Attribute) schema.ListNestedAttribute {
+	attributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the backend",
+			Required:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of the backend",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(backendTypes...),
+			},
+		},
+		"conf_json": schema.StringAttribute{
+			MarkdownDescription: "Configuration of the backend as json, it depends on `type`",
+			Optional:            true,
+		},
+	}
+	for k, v := range extra {
+		attributes[k] = v
+	}
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: attributes,
+		},
+	}
+}
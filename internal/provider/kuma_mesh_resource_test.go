@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMeshResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: localProviderConfig + `
+resource "kuma_mesh" "test" {
+  name                           = "tf-typed-mesh"
+  skip_creating_initial_policies = ["*"]
+  mtls = {
+    enabled_backend = "ca-1"
+    backends = [{
+      name = "ca-1"
+      builtin = {
+        ca_cert = {
+          rsa_bits   = 2048
+          expiration = "10y"
+        }
+      }
+    }]
+  }
+  routing = {
+    zone_egress = true
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_mesh.test", "name", "tf-typed-mesh"),
+					resource.TestCheckResourceAttr("kuma_mesh.test", "mtls.backends.0.builtin.ca_cert.rsa_bits", "2048"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "kuma_mesh.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "tf-typed-mesh",
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: localProviderConfig + `
+resource "kuma_mesh" "test" {
+  name                           = "tf-typed-mesh"
+  skip_creating_initial_policies = ["*"]
+  mtls = {
+    enabled_backend = "ca-1"
+    backends = [{
+      name = "ca-1"
+      mode = "PERMISSIVE"
+      builtin = {}
+    }]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_mesh.test", "mtls.backends.0.mode", "PERMISSIVE"),
+					resource.TestCheckNoResourceAttr("kuma_mesh.test", "routing"),
+				),
+			},
+		},
+	})
+}
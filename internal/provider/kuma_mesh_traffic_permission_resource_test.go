@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccMeshTrafficPermissionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: localProviderConfig + `
+resource "kuma_mesh_traffic_permission" "test" {
+  mesh = "default"
+  name = "tf-typed-mtp"
+  spec = {
+    target_ref = {
+      kind = "Mesh"
+    }
+    from = [{
+      target_ref = {
+        kind = "Mesh"
+      }
+      default = {
+        action = "Allow"
+      }
+    }]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_mesh_traffic_permission.test", "name", "tf-typed-mtp"),
+					resource.TestCheckResourceAttr("kuma_mesh_traffic_permission.test", "spec.from.0.default.action", "Allow"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "kuma_mesh_traffic_permission.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "default/tf-typed-mtp",
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: localProviderConfig + `
+resource "kuma_mesh_traffic_permission" "test" {
+  mesh = "default"
+  name = "tf-typed-mtp"
+  spec = {
+    target_ref = {
+      kind = "Mesh"
+    }
+    from = [{
+      target_ref = {
+        kind = "MeshSubset"
+        tags = {
+          "kuma.io/zone" = "east"
+        }
+      }
+      default = {
+        action = "Deny"
+      }
+    }]
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_mesh_traffic_permission.test", "spec.from.0.default.action", "Deny"),
+					resource.TestCheckResourceAttr("kuma_mesh_traffic_permission.test", "spec.from.0.target_ref.tags.kuma.io/zone", "east"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMeshTrafficPermissionResourceImport(t *testing.T) {
+	const mtp = `
+resource "kuma_mesh_traffic_permission" "test" {
+  mesh = "default"
+  name = "tf-typed-mtp-import"
+  spec = {
+    target_ref = {
+      kind = "Mesh"
+    }
+    from = [{
+      target_ref = {
+        kind = "Mesh"
+      }
+      default = {
+        action = "Allow"
+      }
+    }]
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: localProviderConfig + mtp,
+			},
+			// Forget the policy without deleting it
+			{
+				Config: localProviderConfig + `
+removed {
+  from = kuma_mesh_traffic_permission.test
+  lifecycle {
+    destroy = false
+  }
+}
+`,
+			},
+			// The labels added by the control-plane aren't imported, the plan after the import is empty.
+			{
+				Config: localProviderConfig + mtp + `
+import {
+  to = kuma_mesh_traffic_permission.test
+  id = "default/tf-typed-mtp-import"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config:   localProviderConfig + mtp,
+				PlanOnly: true,
+			},
+		},
+	})
+}
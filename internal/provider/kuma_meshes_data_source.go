@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KumaMeshesDataSource{}
+var _ datasource.DataSourceWithConfigure = &KumaMeshesDataSource{}
+
+func NewKumaMeshesDataSource() datasource.DataSource {
+	return &KumaMeshesDataSource{}
+}
+
+// KumaMeshesDataSource lists the meshes.
+type KumaMeshesDataSource struct {
+	client   kumaapi.Client
+	metadata kumaapi.Metadata
+}
+
+// KumaMeshesDataSourceModel describes the data source data model.
+type KumaMeshesDataSourceModel struct {
+	NameContains types.String              `tfsdk:"name_contains"`
+	Labels       types.Map                 `tfsdk:"labels"`
+	PageSize     types.Int64               `tfsdk:"page_size"`
+	Items        []KumaMeshDataSourceModel `tfsdk:"items"`
+}
+
+func (d *KumaMeshesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_meshes"
+}
+
+func (d *KumaMeshesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	item := meshDataSourceAttributes()
+	item["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the mesh",
+		Computed:            true,
+	}
+	attributes := listFilterAttributes("meshes")
+	attributes["items"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The meshes, with the same attributes as the `kuma_mesh` data source",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: item,
+		},
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the meshes, e.g. to create a policy in each of them.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *KumaMeshesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, metadata, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = client
+	d.metadata = metadata
+}
+
+func (d *KumaMeshesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KumaMeshesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourcePath, _, diags := resolveResource(d.metadata, "Mesh", "")
+	resp.Diagnostics.Append(diags...)
+	filters, diags := listFilters(ctx, data.NameContains, data.Labels, data.PageSize)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	list, err := d.client.ListResources(ctx, "", resourcePath, filters)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Unable to list meshes", err, func(string) path.Path { return path.Empty() })...)
+		return
+	}
+	data.Items = make([]KumaMeshDataSourceModel, len(list))
+	for i, res := range list {
+		resp.Diagnostics.Append(data.Items[i].setMesh(ctx, res)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KumaPoliciesForDataplaneDataSource{}
+var _ datasource.DataSourceWithConfigure = &KumaPoliciesForDataplaneDataSource{}
+
+func NewKumaPoliciesForDataplaneDataSource() datasource.DataSource {
+	return &KumaPoliciesForDataplaneDataSource{}
+}
+
+// KumaPoliciesForDataplaneDataSource reads the rules computed from the policies applied to a dataplane.
+type KumaPoliciesForDataplaneDataSource struct {
+	client   kumaapi.Client
+	metadata kumaapi.Metadata
+}
+
+// KumaPoliciesForDataplaneDataSourceModel describes the data source data model.
+type KumaPoliciesForDataplaneDataSourceModel struct {
+	Mesh      types.String                    `tfsdk:"mesh"`
+	Dataplane types.String                    `tfsdk:"dataplane"`
+	Rules     map[string]KumaPolicyRulesModel `tfsdk:"rules"`
+}
+
+// KumaPolicyRulesModel describes the rules computed from the policies of a type.
+type KumaPolicyRulesModel struct {
+	ToRules   []KumaRuleModel     `tfsdk:"to_rules"`
+	FromRules []KumaFromRuleModel `tfsdk:"from_rules"`
+	ProxyRule *KumaProxyRuleModel `tfsdk:"proxy_rule"`
+	Warnings  []types.String      `tfsdk:"warnings"`
+}
+
+// KumaRuleModel describes the conf applied to the outgoing traffic matching the matchers.
+type KumaRuleModel struct {
+	Matchers []KumaRuleMatcherModel `tfsdk:"matchers"`
+	Conf     types.String           `tfsdk:"conf"`
+	Origins  []KumaResourceKeyModel `tfsdk:"origins"`
+}
+
+// KumaFromRuleModel describes the conf applied to the traffic received by an inbound matching the matchers.
+type KumaFromRuleModel struct {
+	InboundPort types.Int64            `tfsdk:"inbound_port"`
+	Matchers    []KumaRuleMatcherModel `tfsdk:"matchers"`
+	Conf        types.String           `tfsdk:"conf"`
+	Origins     []KumaResourceKeyModel `tfsdk:"origins"`
+}
+
+// KumaProxyRuleModel describes the conf applied to the whole dataplane.
+type KumaProxyRuleModel struct {
+	Conf    types.String           `tfsdk:"conf"`
+	Origins []KumaResourceKeyModel `tfsdk:"origins"`
+}
+
+// KumaRuleMatcherModel describes a matcher of a rule.
+type KumaRuleMatcherModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+	Not   types.Bool   `tfsdk:"not"`
+}
+
+// KumaResourceKeyModel identifies a resource.
+type KumaResourceKeyModel struct {
+	Type types.String `tfsdk:"type"`
+	Mesh types.String `tfsdk:"mesh"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *KumaPoliciesForDataplaneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policies_for_dataplane"
+}
+
+func (d *KumaPoliciesForDataplaneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	matchers := schema.ListNestedAttribute{
+		MarkdownDescription: "The tags the traffic must match for the rule to apply, all the traffic matches when empty",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"key": schema.StringAttribute{
+					MarkdownDescription: "The tag of the traffic",
+					Computed:            true,
+				},
+				"value": schema.StringAttribute{
+					MarkdownDescription: "The value of the tag",
+					Computed:            true,
+				},
+				"not": schema.BoolAttribute{
+					MarkdownDescription: "Whether the traffic must not have this value",
+					Computed:            true,
+				},
+			},
+		},
+	}
+	conf := schema.StringAttribute{
+		MarkdownDescription: "The conf applied by the rule as json, the result of merging the `default` of the origins. Use `jsondecode` to access its fields",
+		Computed:            true,
+	}
+	origins := schema.ListNestedAttribute{
+		MarkdownDescription: "The policies merged into the conf, from the least to the most specific",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The type of the policy",
+					Computed:            true,
+				},
+				"mesh": schema.StringAttribute{
+					MarkdownDescription: "The mesh of the policy",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "The name of the policy",
+					Computed:            true,
+				},
+			},
+		},
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the rules computed by the control-plane from the policies applied to a dataplane, like `kumactl inspect dataplane --type=policies`. " +
+			"Use it in `check` blocks to assert that the policies resolve as intended. Requires Kuma >= 2.6.",
+
+		Attributes: map[string]schema.Attribute{
+			"mesh": schema.StringAttribute{
+				MarkdownDescription: "The mesh of the dataplane",
+				Required:            true,
+			},
+			"dataplane": schema.StringAttribute{
+				MarkdownDescription: "The name of the dataplane",
+				Required:            true,
+			},
+			"rules": schema.MapNestedAttribute{
+				MarkdownDescription: "The rules by policy type (e.g. `MeshTimeout`), only the types of the policies applied to the dataplane are present",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"to_rules": schema.ListNestedAttribute{
+							MarkdownDescription: "The rules of the outgoing traffic",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"matchers": matchers,
+									"conf":     conf,
+									"origins":  origins,
+								},
+							},
+						},
+						"from_rules": schema.ListNestedAttribute{
+							MarkdownDescription: "The rules of the incoming traffic of each inbound",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"inbound_port": schema.Int64Attribute{
+										MarkdownDescription: "The port of the inbound receiving the traffic",
+										Computed:            true,
+									},
+									"matchers": matchers,
+									"conf":     conf,
+									"origins":  origins,
+								},
+							},
+						},
+						"proxy_rule": schema.SingleNestedAttribute{
+							MarkdownDescription: "The rule applied to the whole dataplane, for the policies without `to` and `from` (e.g. `MeshTrace`)",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"conf":    conf,
+								"origins": origins,
+							},
+						},
+						"warnings": schema.ListAttribute{
+							MarkdownDescription: "The problems found by the control-plane while computing the rules",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *KumaPoliciesForDataplaneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, metadata, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = client
+	d.metadata = metadata
+}
+
+func (d *KumaPoliciesForDataplaneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KumaPoliciesForDataplaneDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	resp.Diagnostics.Append(requireVersion(d.metadata, "Inspecting the policies of a dataplane", rulesInspectVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := d.client.DataplaneRules(ctx, data.Mesh.ValueString(), data.Dataplane.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Unable to inspect dataplane", err, func(string) path.Path { return path.Root("dataplane") })...)
+		return
+	}
+	if rules == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("dataplane"), "dataplane not found",
+			fmt.Sprintf("Dataplane `%s` doesn't exist in mesh `%s`", data.Dataplane.ValueString(), data.Mesh.ValueString()))
+		return
+	}
+	resp.Diagnostics.Append(data.setRules(*rules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setRules stores the rules returned by the control-plane in the computed attributes.
+func (m *KumaPoliciesForDataplaneDataSourceModel) setRules(rules kumaapi.DataplaneRules) diag.Diagnostics {
+	var diags diag.Diagnostics
+	confValue := func(conf json.RawMessage) types.String {
+		if len(conf) == 0 {
+			return types.StringNull()
+		}
+		var b bytes.Buffer
+		if err := json.Compact(&b, conf); err != nil {
+			diags.AddError("client Error", fmt.Sprintf("Failed to decode the conf of a rule, got error: %s", err))
+			return types.StringNull()
+		}
+		return types.StringValue(b.String())
+	}
+	matchersValue := func(matchers []kumaapi.RuleMatcher) []KumaRuleMatcherModel {
+		out := make([]KumaRuleMatcherModel, len(matchers))
+		for i, v := range matchers {
+			out[i] = KumaRuleMatcherModel{Key: types.StringValue(v.Key), Value: types.StringValue(v.Value), Not: types.BoolValue(v.Not)}
+		}
+		return out
+	}
+	originsValue := func(origins []kumaapi.ResourceKey) []KumaResourceKeyModel {
+		out := make([]KumaResourceKeyModel, len(origins))
+		for i, v := range origins {
+			out[i] = KumaResourceKeyModel{Type: types.StringValue(v.Type), Mesh: optionalString(v.Mesh), Name: types.StringValue(v.Name)}
+		}
+		return out
+	}
+
+	m.Rules = map[string]KumaPolicyRulesModel{}
+	for _, policy := range rules.Rules {
+		out := KumaPolicyRulesModel{
+			ToRules:   make([]KumaRuleModel, len(policy.ToRules)),
+			FromRules: []KumaFromRuleModel{},
+			Warnings:  make([]types.String, len(policy.Warnings)),
+		}
+		for i, rule := range policy.ToRules {
+			out.ToRules[i] = KumaRuleModel{Matchers: matchersValue(rule.Matchers), Conf: confValue(rule.Conf), Origins: originsValue(rule.Origin)}
+		}
+		for _, from := range policy.FromRules {
+			for _, rule := range from.Rules {
+				out.FromRules = append(out.FromRules, KumaFromRuleModel{
+					InboundPort: types.Int64Value(int64(from.Inbound.Port)),
+					Matchers:    matchersValue(rule.Matchers),
+					Conf:        confValue(rule.Conf),
+					Origins:     originsValue(rule.Origin),
+				})
+			}
+		}
+		if policy.ProxyRule != nil {
+			out.ProxyRule = &KumaProxyRuleModel{Conf: confValue(policy.ProxyRule.Conf), Origins: originsValue(policy.ProxyRule.Origin)}
+		}
+		for i, w := range policy.Warnings {
+			out.Warnings[i] = types.StringValue(w)
+		}
+		m.Rules[policy.Type] = out
+	}
+	return diags
+}
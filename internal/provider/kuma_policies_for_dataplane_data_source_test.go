@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPoliciesForDataplaneSetRules(t *testing.T) {
+	rules := kumaapi.DataplaneRules{}
+	err := json.Unmarshal([]byte(`{"rules": [
+		{
+			"type": "MeshTimeout",
+			"toRules": [{"matchers": [{"key": "kuma.io/service", "value": "db", "not": true}], "conf": {"idleTimeout": "1h"}, "origin": [{"type": "MeshTimeout", "mesh": "default", "name": "mt-1"}]}],
+			"fromRules": [
+				{"inbound": {"port": 8080}, "rules": [{"conf": {"idleTimeout": "2h"}}]},
+				{"inbound": {"port": 8081}, "rules": [{"conf": {"idleTimeout": "3h"}}]}
+			],
+			"warnings": ["conflicting policies"]
+		},
+		{"type": "MeshTrace", "proxyRule": {"conf": {"sampling": {"overall": 10}}}}
+	]}`), &rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data KumaPoliciesForDataplaneDataSourceModel
+	if diags := data.setRules(rules); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := map[string]KumaPolicyRulesModel{
+		"MeshTimeout": {
+			ToRules: []KumaRuleModel{{
+				Matchers: []KumaRuleMatcherModel{{Key: types.StringValue("kuma.io/service"), Value: types.StringValue("db"), Not: types.BoolValue(true)}},
+				Conf:     types.StringValue(`{"idleTimeout":"1h"}`),
+				Origins:  []KumaResourceKeyModel{{Type: types.StringValue("MeshTimeout"), Mesh: types.StringValue("default"), Name: types.StringValue("mt-1")}},
+			}},
+			FromRules: []KumaFromRuleModel{
+				{InboundPort: types.Int64Value(8080), Matchers: []KumaRuleMatcherModel{}, Conf: types.StringValue(`{"idleTimeout":"2h"}`), Origins: []KumaResourceKeyModel{}},
+				{InboundPort: types.Int64Value(8081), Matchers: []KumaRuleMatcherModel{}, Conf: types.StringValue(`{"idleTimeout":"3h"}`), Origins: []KumaResourceKeyModel{}},
+			},
+			Warnings: []types.String{types.StringValue("conflicting policies")},
+		},
+		"MeshTrace": {
+			ToRules:   []KumaRuleModel{},
+			FromRules: []KumaFromRuleModel{},
+			ProxyRule: &KumaProxyRuleModel{Conf: types.StringValue(`{"sampling":{"overall":10}}`), Origins: []KumaResourceKeyModel{}},
+			Warnings:  []types.String{},
+		},
+	}
+	if diff := cmp.Diff(want, data.Rules); diff != "" {
+		t.Errorf("unexpected rules (-want +got):\n%s", diff)
+	}
+}
+
+func TestAccPoliciesForDataplaneDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: localProviderConfig + `
+resource "kuma_raw_resource" "dataplane" {
+  raw_json = jsonencode({
+    type = "Dataplane"
+    name = "tf-rules-dp"
+    mesh = "default"
+    networking = {
+      address = "127.0.0.1"
+      inbound = [{
+        port        = 8080
+        servicePort = 80
+        tags        = { "kuma.io/service" = "tf-rules-web" }
+      }]
+    }
+  })
+}
+
+resource "kuma_raw_resource" "timeout" {
+  raw_json = jsonencode({
+    type = "MeshTimeout"
+    name = "tf-rules-timeout"
+    mesh = "default"
+    spec = {
+      targetRef = { kind = "MeshService", name = "tf-rules-web" }
+      to = [{
+        targetRef = { kind = "Mesh" }
+        default   = { idleTimeout = "42s" }
+      }]
+    }
+  })
+}
+
+data "kuma_policies_for_dataplane" "web" {
+  mesh       = "default"
+  dataplane  = kuma_raw_resource.dataplane.name
+  depends_on = [kuma_raw_resource.timeout]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.kuma_policies_for_dataplane.web", "rules.MeshTimeout.to_rules.0.conf"),
+				),
+			},
+		},
+	})
+}
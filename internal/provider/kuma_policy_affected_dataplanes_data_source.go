@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KumaPolicyAffectedDataplanesDataSource{}
+var _ datasource.DataSourceWithConfigure = &KumaPolicyAffectedDataplanesDataSource{}
+
+func NewKumaPolicyAffectedDataplanesDataSource() datasource.DataSource {
+	return &KumaPolicyAffectedDataplanesDataSource{}
+}
+
+// KumaPolicyAffectedDataplanesDataSource lists the dataplanes selected by a policy.
+type KumaPolicyAffectedDataplanesDataSource struct {
+	client   kumaapi.Client
+	metadata kumaapi.Metadata
+}
+
+// KumaPolicyAffectedDataplanesDataSourceModel describes the data source data model.
+type KumaPolicyAffectedDataplanesDataSourceModel struct {
+	Type       types.String                 `tfsdk:"type"`
+	Mesh       types.String                 `tfsdk:"mesh"`
+	Name       types.String                 `tfsdk:"name"`
+	Dataplanes []KumaAffectedDataplaneModel `tfsdk:"dataplanes"`
+}
+
+// KumaAffectedDataplaneModel describes a dataplane selected by a policy.
+type KumaAffectedDataplaneModel struct {
+	Kind   types.String `tfsdk:"kind"`
+	Mesh   types.String `tfsdk:"mesh"`
+	Name   types.String `tfsdk:"name"`
+	Labels types.Map    `tfsdk:"labels"`
+}
+
+func (d *KumaPolicyAffectedDataplanesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_affected_dataplanes"
+}
+
+func (d *KumaPolicyAffectedDataplanesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the dataplanes, gateways included, selected by a policy, e.g. to check the blast radius of a change before applying it. Requires Kuma >= 2.6.",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the policy (e.g. `MeshTrafficPermission`)",
+				Required:            true,
+			},
+			"mesh": schema.StringAttribute{
+				MarkdownDescription: "The mesh of the policy",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the policy",
+				Required:            true,
+			},
+			"dataplanes": schema.ListNestedAttribute{
+				MarkdownDescription: "The dataplanes selected by the policy",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "The kind of the dataplane: `sidecar`, `builtin_gateway` or `delegated_gateway`, like `kind` in `kuma_dataplanes`",
+							Computed:            true,
+						},
+						"mesh": schema.StringAttribute{
+							MarkdownDescription: "The mesh of the dataplane",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the dataplane",
+							Computed:            true,
+						},
+						"labels": schema.MapAttribute{
+							MarkdownDescription: "The labels of the dataplane",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *KumaPolicyAffectedDataplanesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, metadata, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = client
+	d.metadata = metadata
+}
+
+func (d *KumaPolicyAffectedDataplanesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KumaPolicyAffectedDataplanesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	resp.Diagnostics.Append(requireVersion(d.metadata, "Inspecting the dataplanes of a policy", rulesInspectVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyPath := d.metadata.PathForResource(data.Type.ValueString())
+	if policyPath == "" {
+		// Reuse the message of the other resources.
+		_, _, diags := resolveResource(d.metadata, data.Type.ValueString(), data.Mesh.ValueString())
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if res, _ := d.metadata.LookupResource(data.Type.ValueString()); !res.IsPolicy {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "not a policy", fmt.Sprintf("Resource type '%s' is not a policy, it doesn't select dataplanes", res.Name))
+		return
+	}
+
+	dataplanes, err := d.client.PolicyDataplanes(ctx, data.Mesh.ValueString(), policyPath, data.Name.ValueString(), kumaapi.ListFilters{})
+	var apiErr *kumaapi.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "policy not found",
+			fmt.Sprintf("%s `%s` doesn't exist in mesh `%s`", data.Type.ValueString(), data.Name.ValueString(), data.Mesh.ValueString()))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Unable to inspect policy", err, func(string) path.Path { return path.Root("name") })...)
+		return
+	}
+	// The inspect api only returns the metadata of the dataplanes, the gateways are listed to tell them apart.
+	gateways, err := d.client.ListDataplaneOverviews(ctx, data.Mesh.ValueString(), kumaapi.ListFilters{Gateway: "true"})
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Unable to list gateways", err, func(string) path.Path { return path.Root("mesh") })...)
+		return
+	}
+	gatewayTypes := map[string]string{}
+	for _, gw := range gateways {
+		gatewayTypes[gw.Name] = gw.GatewayType()
+	}
+	resp.Diagnostics.Append(data.setDataplanes(ctx, dataplanes, gatewayTypes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setDataplanes stores the dataplanes returned by the control-plane in the computed attributes, gatewayTypes are the
+// gateway types of the gateways of the mesh by name.
+func (m *KumaPolicyAffectedDataplanesDataSourceModel) setDataplanes(ctx context.Context, dataplanes []kumaapi.ResourceMeta, gatewayTypes map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.Dataplanes = make([]KumaAffectedDataplaneModel, len(dataplanes))
+	for i, dp := range dataplanes {
+		labels := dp.Labels
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labelsValue, d := types.MapValueFrom(ctx, types.StringType, labels)
+		diags.Append(d...)
+		m.Dataplanes[i] = KumaAffectedDataplaneModel{
+			Kind:   types.StringValue(dataplaneKind(gatewayTypes[dp.Name])),
+			Mesh:   types.StringValue(dp.Mesh),
+			Name:   types.StringValue(dp.Name),
+			Labels: labelsValue,
+		}
+	}
+	return diags
+}
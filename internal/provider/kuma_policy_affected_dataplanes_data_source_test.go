@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPolicyAffectedDataplanesSetDataplanes(t *testing.T) {
+	var data KumaPolicyAffectedDataplanesDataSourceModel
+	diags := data.setDataplanes(context.Background(), []kumaapi.ResourceMeta{
+		{Type: "Dataplane", Mesh: "default", Name: "web-1", Labels: map[string]string{"kuma.io/zone": "east"}},
+		{Type: "Dataplane", Mesh: "default", Name: "gw-1"},
+		{Type: "Dataplane", Mesh: "default", Name: "gw-2"},
+	}, map[string]string{"gw-1": "builtin", "gw-2": "delegated"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := []KumaAffectedDataplaneModel{
+		{Kind: types.StringValue("sidecar"), Mesh: types.StringValue("default"), Name: types.StringValue("web-1"), Labels: types.MapValueMust(types.StringType, map[string]attr.Value{"kuma.io/zone": types.StringValue("east")})},
+		{Kind: types.StringValue("builtin_gateway"), Mesh: types.StringValue("default"), Name: types.StringValue("gw-1"), Labels: types.MapValueMust(types.StringType, map[string]attr.Value{})},
+		{Kind: types.StringValue("delegated_gateway"), Mesh: types.StringValue("default"), Name: types.StringValue("gw-2"), Labels: types.MapValueMust(types.StringType, map[string]attr.Value{})},
+	}
+	if diff := cmp.Diff(want, data.Dataplanes); diff != "" {
+		t.Errorf("unexpected dataplanes (-want +got):\n%s", diff)
+	}
+}
+
+func TestAccPolicyAffectedDataplanesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: localProviderConfig + `
+resource "kuma_raw_resource" "dataplane" {
+  raw_json = jsonencode({
+    type = "Dataplane"
+    name = "tf-affected-dp"
+    mesh = "default"
+    networking = {
+      address = "127.0.0.1"
+      inbound = [{
+        port        = 8080
+        servicePort = 80
+        tags        = { "kuma.io/service" = "tf-affected-web" }
+      }]
+    }
+  })
+}
+
+resource "kuma_raw_resource" "timeout" {
+  raw_json = jsonencode({
+    type = "MeshTimeout"
+    name = "tf-affected-timeout"
+    mesh = "default"
+    spec = {
+      targetRef = { kind = "MeshService", name = "tf-affected-web" }
+      to = [{
+        targetRef = { kind = "Mesh" }
+        default   = { idleTimeout = "42s" }
+      }]
+    }
+  })
+}
+
+data "kuma_policy_affected_dataplanes" "timeout" {
+  type       = "MeshTimeout"
+  mesh       = "default"
+  name       = kuma_raw_resource.timeout.name
+  depends_on = [kuma_raw_resource.dataplane]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kuma_policy_affected_dataplanes.timeout", "dataplanes.#", "1"),
+					resource.TestCheckResourceAttr("data.kuma_policy_affected_dataplanes.timeout", "dataplanes.0.name", "tf-affected-dp"),
+					resource.TestCheckResourceAttr("data.kuma_policy_affected_dataplanes.timeout", "dataplanes.0.kind", "sidecar"),
+				),
+			},
+		},
+	})
+}
@@ -4,17 +4,19 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/Kong/terraform-provider-kuma/internal/schemas"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"strings"
 )
 
@@ -22,6 +24,7 @@ import (
 var _ resource.Resource = &KumaRawResource{}
 var _ resource.ResourceWithImportState = &KumaRawResource{}
 var _ resource.ResourceWithModifyPlan = &KumaRawResource{}
+var _ resource.ResourceWithValidateConfig = &KumaRawResource{}
 
 func NewKumaMeshedResource() resource.Resource {
 	return &KumaRawResource{}
@@ -35,32 +38,116 @@ type KumaRawResource struct {
 
 // KumaMeshedResourceModel describes the resource data model.
 type KumaMeshedResourceModel struct {
-	Name    types.String `tfsdk:"name"`
-	Type    types.String `tfsdk:"type"`
-	Mesh    types.String `tfsdk:"mesh"`
-	RawJson types.String `tfsdk:"raw_json"`
+	Name    types.String  `tfsdk:"name"`
+	Type    types.String  `tfsdk:"type"`
+	Mesh    types.String  `tfsdk:"mesh"`
+	RawJson KumaJSONValue `tfsdk:"raw_json"`
+	// ObservedJson is the resource as returned by the control-plane, timestamps excluded.
+	ObservedJson     types.String `tfsdk:"observed_json"`
+	CreationTime     types.String `tfsdk:"creation_time"`
+	ModificationTime types.String `tfsdk:"modification_time"`
 }
 
 func (r *KumaRawResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_raw_resource"
 }
 
+func (r *KumaRawResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rawJson KumaJSONValue
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("raw_json"), &rawJson)...)
+	if resp.Diagnostics.HasError() || rawJson.IsNull() || rawJson.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(validateRawJson(rawJson.ValueString())...)
+}
+
+// validateRawJson checks the resource against the vendored schema of its type, types without a schema aren't checked.
+// Unknown fields are only warnings as the control-plane may be newer than the vendored schemas, unless they are close
+// to a known field: a typo would be rejected by the control-plane in the middle of the apply.
+func validateRawJson(rawJson string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	doc := map[string]interface{}{}
+	d := json.NewDecoder(bytes.NewReader([]byte(rawJson)))
+	d.UseNumber()
+	if err := d.Decode(&doc); err != nil {
+		diags.AddAttributeError(path.Root("raw_json"), "invalid raw_json", fmt.Sprintf("json parse failed, error: %s", err))
+		return diags
+	}
+	resType, _ := doc["type"].(string)
+	s, ok, err := schemas.Policy(resType)
+	if err != nil {
+		diags.AddError("invalid schemas", fmt.Sprintf("Failed to load the schemas of the policies, please report this issue to the provider developers, error: %s", err))
+		return diags
+	}
+	if !ok {
+		return diags
+	}
+	// Timestamps are returned by the control-plane but aren't part of the schema.
+	delete(doc, "creationTime")
+	delete(doc, "modificationTime")
+	for _, e := range s.Validate(doc) {
+		if e.UnknownField && e.Suggestion == "" {
+			diags.AddAttributeWarning(path.Root("raw_json"), "unknown field in raw_json",
+				fmt.Sprintf("%s doesn't match its schema at %s\n\nThe field may only be supported by a newer version of Kuma than the schemas of the provider", resType, e.Error()))
+			continue
+		}
+		diags.AddAttributeError(path.Root("raw_json"), "invalid raw_json", fmt.Sprintf("%s doesn't match its schema at %s", resType, e.Error()))
+	}
+	return diags
+}
+
 func (r *KumaRawResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// Skip when deleting
 	if req.Plan.Raw.IsNull() {
 		return
 	}
-	// Do nothing if there is no state value.
 	plan := KumaMeshedResourceModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if !plan.Name.IsUnknown() {
+	if plan.RawJson.IsUnknown() || resp.Diagnostics.HasError() {
+		return
+	}
+	// The name is only unknown when there is no state value.
+	if plan.Name.IsUnknown() {
+		resp.Diagnostics.Append(r.extractMeta(&plan)...)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+	if r.client == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		state := KumaMeshedResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if state.RawJson.Equal(plan.RawJson) {
+			return
+		}
+	}
+	doc := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(plan.RawJson.ValueString()), &doc); err == nil {
+		resp.Diagnostics.Append(checkFeatures(r.metadata, plan.Type.ValueString(), doc, rawJsonPath)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resourcePath, mesh, diags := resolveResource(r.metadata, plan.Type.ValueString(), plan.Mesh.ValueString())
+	if diags.HasError() {
+		// Reported when applying.
 		return
 	}
+	resp.Diagnostics.Append(validateResource(ctx, r.client, mesh, resourcePath, plan.Name.ValueString(), plan.RawJson.ValueString(), rawJsonPath)...)
+}
+
+func rawJsonPath(string) path.Path {
+	return path.Root("raw_json")
+}
 
+// extractMeta sets the name, type and mesh of the plan from its raw_json.
+func (r *KumaRawResource) extractMeta(plan *KumaMeshedResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
 	meta := map[string]interface{}{}
 	if err := json.Unmarshal([]byte(plan.RawJson.ValueString()), &meta); err != nil {
-		resp.Diagnostics.AddError("failed extracting meta", fmt.Sprintf("json parse failed, error: %s", err))
-		return
+		diags.AddError("failed extracting meta", fmt.Sprintf("json parse failed, error: %s", err))
+		return diags
 	}
 	if v, ok := meta["name"].(string); ok {
 		plan.Name = types.StringValue(v)
@@ -68,10 +155,28 @@ func (r *KumaRawResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 	if v, ok := meta["type"].(string); ok {
 		plan.Type = types.StringValue(v)
 	}
-	if v, ok := meta["mesh"].(string); ok {
+	plan.Mesh = types.StringNull()
+	if v, ok := meta["mesh"].(string); ok && v != "" {
 		plan.Mesh = types.StringValue(v)
 	}
-	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	if res, ok := r.metadata.LookupResource(plan.Type.ValueString()); ok {
+		if res.IsMeshed && plan.Mesh.IsNull() {
+			diags.AddAttributeError(path.Root("raw_json"), "missing mesh", fmt.Sprintf("Resource type '%s' is scoped to a mesh, `mesh` must be set", res.Name))
+		}
+		if !res.IsMeshed && !plan.Mesh.IsNull() {
+			diags.AddAttributeError(path.Root("raw_json"), "unexpected mesh", fmt.Sprintf("Resource type '%s' is global, `mesh` must not be set", res.Name))
+		}
+	}
+	return diags
+}
+
+// resolveMeta extracts the name, type and mesh left unknown by the plan, raw_json is only known when applying if it
+// depends on resources that aren't created yet.
+func (r *KumaRawResource) resolveMeta(data *KumaMeshedResourceModel) diag.Diagnostics {
+	if !data.Name.IsUnknown() && !data.Type.IsUnknown() && !data.Mesh.IsUnknown() {
+		return nil
+	}
+	return r.extractMeta(data)
 }
 
 func (r *KumaRawResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -81,11 +186,28 @@ func (r *KumaRawResource) Schema(ctx context.Context, req resource.SchemaRequest
 
 		Attributes: map[string]schema.Attribute{
 			"raw_json": schema.StringAttribute{
-				MarkdownDescription: "The entity as you would have created it in json format `kumactl apply -f`",
-				Required:            true,
+				MarkdownDescription: "The entity as you would have created it in json format `kumactl apply -f`, policies are validated against their schema during plan. " +
+					"Differences in formatting, key ordering, values defaulted by the control-plane and labels it adds (e.g. `kuma.io/origin`) are ignored",
+				CustomType: KumaJSONType{},
+				Required:   true,
+			},
+			"observed_json": schema.StringAttribute{
+				MarkdownDescription: "The entity as returned by the control-plane, including the values it defaulted. Only the fields set in `raw_json` are checked for drift",
+				Computed:            true,
+			},
+			"creation_time": schema.StringAttribute{
+				MarkdownDescription: "The time the entity was created in the control-plane",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modification_time": schema.StringAttribute{
+				MarkdownDescription: "The time the entity was last modified in the control-plane",
+				Computed:            true,
 			},
 			"mesh": schema.StringAttribute{
-				MarkdownDescription: "The mesh the resource is part of, if unset it uses `json_body` to extract it. It is recommended to not set it",
+				MarkdownDescription: "The mesh the resource is part of, if unset it uses `json_body` to extract it. It is recommended to not set it. Empty for global resources like `Mesh` or `Zone`",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -118,21 +240,9 @@ func (r *KumaRawResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(kumaapi.Client)
-	metadata, err := client.HeartBeat(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("failed to heartbeat control-plane", err.Error())
-
-	}
-
-	tflog.Info(ctx, "successfully checked connection", map[string]interface{}{"info": metadata})
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
+	client, metadata, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -145,62 +255,105 @@ func (r *KumaRawResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-
-	resourcePath := r.metadata.PathForResource(data.Type.ValueString())
-	if resourcePath == "" {
-		resp.Diagnostics.AddError("unsupported resource type", fmt.Sprintf("Resource type '%s' is not supported by the server", data.Type.ValueString()))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.resolveMeta(&data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resourcePath, mesh, diags := resolveResource(r.metadata, data.Type.ValueString(), data.Mesh.ValueString())
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	res, err := r.client.FetchResource(ctx, data.Mesh.ValueString(), resourcePath, data.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Unable to fetch resource have create, got error: %s", err))
+
+	res, diags := createResource(ctx, r.client, mesh, resourcePath, data.Name.ValueString(), data.RawJson.ValueString(), rawJsonPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if res != nil {
-		resp.Diagnostics.AddError("Unable to Create Resource", "Resource already exists!")
+	if err := data.setObserved(res); err != nil {
+		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Failed to normalize resource, get error: %s", err))
 		return
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
 
-	err = r.client.PutResource(ctx, data.Mesh.ValueString(), resourcePath, data.Name.ValueString(), data.RawJson.ValueString())
+// setObserved stores the resource returned by the control-plane in the computed attributes.
+func (m *KumaMeshedResourceModel) setObserved(res []byte) error {
+	observed, err := decodeObserved(res)
 	if err != nil {
-		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Unable to create resource, got error: %s", err))
-		return
+		return err
 	}
-	res, err = r.client.FetchResource(ctx, data.Mesh.ValueString(), resourcePath, data.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Unable to fetch resource after create, got error: %s", err))
-		return
+	m.CreationTime = observed.creationTime
+	m.ModificationTime = observed.modificationTime
+	m.ObservedJson = types.StringValue(observed.json)
+	return nil
+}
+
+// observedResource is a resource returned by the control-plane with its timestamps apart from its json.
+type observedResource struct {
+	// doc is the decoded resource without its timestamps.
+	doc              map[string]interface{}
+	json             string
+	creationTime     types.String
+	modificationTime types.String
+}
+
+// decodeObserved splits a resource returned by the control-plane in its timestamps and the rest of its json.
+func decodeObserved(res []byte) (observedResource, error) {
+	observed := observedResource{
+		doc:              map[string]interface{}{},
+		creationTime:     types.StringNull(),
+		modificationTime: types.StringNull(),
 	}
-	if res == nil {
-		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Resource didn't exist just after the put, got error: %s", err))
-		return
+	d := json.NewDecoder(bytes.NewReader(res))
+	d.UseNumber()
+	if err := d.Decode(&observed.doc); err != nil {
+		return observed, fmt.Errorf("fail unmarshalling: %w", err)
 	}
-	out, err := removeTimes(res)
+	if v, ok := observed.doc["creationTime"].(string); ok {
+		observed.creationTime = types.StringValue(v)
+	}
+	if v, ok := observed.doc["modificationTime"].(string); ok {
+		observed.modificationTime = types.StringValue(v)
+	}
+	delete(observed.doc, "creationTime")
+	delete(observed.doc, "modificationTime")
+
+	out, err := json.Marshal(observed.doc)
 	if err != nil {
-		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Failed to normalize resource, get error: %s", err))
-		return
+		return observed, fmt.Errorf("fail marshalling: %w", err)
 	}
-	data.RawJson = types.StringValue(string(out))
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	observed.json = string(out)
+	return observed, nil
 }
 
-func removeTimes(data []byte) ([]byte, error) {
-	m := map[string]interface{}{}
-	if err := json.Unmarshal(data, &m); err != nil {
-		return nil, fmt.Errorf("fail unmarshalling: %w", err)
+// managedJSON returns the observed resource restricted to the fields set in desired, desired is returned as is when
+// the control-plane only added data to it. Without desired (e.g. on import) it's the observed resource.
+func managedJSON(desired KumaJSONValue, observed string) (KumaJSONValue, error) {
+	if !desired.IsNull() && jsonSemanticallyEqual(desired.ValueString(), observed) {
+		return desired, nil
 	}
-	delete(m, "creationTime")
-	delete(m, "modificationTime")
-
-	out, err := json.Marshal(m)
+	o, err := normalizedJSON(observed)
 	if err != nil {
-		return nil, fmt.Errorf("fail marshalling: %w", err)
+		return desired, err
 	}
-	return out, nil
+	var managed interface{} = o
+	if !desired.IsNull() {
+		d, err := normalizedJSON(desired.ValueString())
+		if err != nil {
+			return desired, err
+		}
+		managed = onlyManagedFields(o, d)
+	}
+	out, err := json.Marshal(managed)
+	if err != nil {
+		return desired, err
+	}
+	return NewKumaJSONValue(string(out)), nil
 }
 
 func (r *KumaRawResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -213,12 +366,12 @@ func (r *KumaRawResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	resourcePath := r.metadata.PathForResource(data.Type.ValueString())
-	if resourcePath == "" {
-		resp.Diagnostics.AddError("unsupported resource type", fmt.Sprintf("Resource type '%s' is not supported by the server", data.Type.ValueString()))
+	resourcePath, mesh, diags := resolveResource(r.metadata, data.Type.ValueString(), data.Mesh.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	res, err := r.client.FetchResource(ctx, data.Mesh.ValueString(), resourcePath, data.Name.ValueString())
+	res, err := r.client.FetchResource(ctx, mesh, resourcePath, data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Unable to read policy, got error: %s", err))
 		return
@@ -227,12 +380,15 @@ func (r *KumaRawResource) Read(ctx context.Context, req resource.ReadRequest, re
 		resp.State.RemoveResource(ctx)
 		return
 	}
-	out, err := removeTimes(res)
-	if err != nil {
+	if err := data.setObserved(res); err != nil {
 		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Failed to normalize resource, get error: %s", err))
 		return
 	}
-	data.RawJson = types.StringValue(string(out))
+	data.RawJson, err = managedJSON(data.RawJson, data.ObservedJson.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Failed to compare resource, get error: %s", err))
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -244,33 +400,25 @@ func (r *KumaRawResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	resourcePath := r.metadata.PathForResource(data.Type.ValueString())
-	if resourcePath == "" {
-		resp.Diagnostics.AddError("unsupported resource type", fmt.Sprintf("Resource type '%s' is not supported by the server", data.Type.ValueString()))
-		return
-	}
-	err := r.client.PutResource(ctx, data.Mesh.ValueString(), resourcePath, data.Name.ValueString(), data.RawJson.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Unable to create resource, got error: %s", err))
+	resp.Diagnostics.Append(r.resolveMeta(&data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	res, err := r.client.FetchResource(ctx, data.Mesh.ValueString(), resourcePath, data.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Unable to fetch resource after create, got error: %s", err))
+
+	resourcePath, mesh, diags := resolveResource(r.metadata, data.Type.ValueString(), data.Mesh.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if res == nil {
-		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Resource didn't exist just after the put, got error: %s", err))
+	res, diags := putResource(ctx, r.client, mesh, resourcePath, data.Name.ValueString(), data.RawJson.ValueString(), rawJsonPath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := removeTimes(res)
-	if err != nil {
+	if err := data.setObserved(res); err != nil {
 		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Failed to normalize resource, get error: %s", err))
 		return
 	}
-	data.RawJson = types.StringValue(string(out))
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -286,26 +434,12 @@ func (r *KumaRawResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	resourcePath := r.metadata.PathForResource(data.Type.ValueString())
-	if resourcePath == "" {
-		resp.Diagnostics.AddError("unsupported resource type", fmt.Sprintf("Resource type '%s' is not supported by the server", data.Type.ValueString()))
-		return
-	}
-	out, err := r.client.FetchResource(ctx, data.Mesh.ValueString(), resourcePath, data.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Unable to read policy, got error: %s", err))
-		return
-	}
-	if out == nil {
-		resp.Diagnostics.AddWarning("already deleted", "Resource was already deleted")
-		return
-	}
-
-	err = r.client.DeleteResource(ctx, data.Mesh.ValueString(), resourcePath, data.Name.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("delete error", fmt.Sprintf("Unable to delete policy, got error: %s", err))
+	resourcePath, mesh, diags := resolveResource(r.metadata, data.Type.ValueString(), data.Mesh.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(deleteResource(ctx, r.client, mesh, resourcePath, data.Name.ValueString())...)
 }
 
 func (r *KumaRawResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -322,7 +456,11 @@ func (r *KumaRawResource) ImportState(ctx context.Context, req resource.ImportSt
 		parts[1] = resourceName
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mesh"), parts[0])...)
+	mesh := types.StringNull()
+	if parts[0] != "" {
+		mesh = types.StringValue(parts[0])
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mesh"), mesh)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), parts[1])...)
 }
@@ -7,9 +7,111 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+func TestValidateRawJson(t *testing.T) {
+	tests := map[string]struct {
+		rawJson string
+		want    diag.Diagnostics
+	}{
+		"valid policy": {
+			rawJson: `{"type": "MeshTrafficPermission", "name": "mtp", "mesh": "default", "creationTime": "2024-01-01T00:00:00Z", "spec": {"targetRef": {"kind": "Mesh"}}}`,
+		},
+		"type without schema": {
+			rawJson: `{"type": "Mesh", "name": "default", "anything": true}`,
+		},
+		"invalid value": {
+			rawJson: `{"type": "MeshTrafficPermission", "name": "mtp", "mesh": "default", "spec": {"from": [{"targetRef": {"kind": "Mesh"}, "default": {"action": "Permit"}}]}}`,
+			want: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("raw_json"), "invalid raw_json", "MeshTrafficPermission doesn't match its schema at /spec/from/0/default/action: must be one of `Allow`, `Deny`, `AllowWithShadowDeny`"),
+			},
+		},
+		"typo in policy": {
+			rawJson: `{"type": "MeshTrafficPermission", "name": "mtp", "mesh": "default", "spec": {"from": [{"targetRef": {"kind": "Mesh"}, "default": {"acton": "Allow"}}]}}`,
+			want: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("raw_json"), "invalid raw_json", "MeshTrafficPermission doesn't match its schema at /spec/from/0/default/acton: unknown field `acton`, did you mean `action`?"),
+			},
+		},
+		"unknown field": {
+			rawJson: `{"type": "MeshTrafficPermission", "name": "mtp", "mesh": "default", "spec": {"targetRef": {"kind": "Mesh"}, "rules": []}}`,
+			want: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("raw_json"), "unknown field in raw_json", "MeshTrafficPermission doesn't match its schema at /spec/rules: unknown field `rules`\n\nThe field may only be supported by a newer version of Kuma than the schemas of the provider"),
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := validateRawJson(tt.rawJson)
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %v got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestManagedJSON(t *testing.T) {
+	observed := `{"type":"MeshTimeout","name":"mt","mesh":"default","labels":{"kuma.io/origin":"zone"},"spec":{"targetRef":{"kind":"Mesh"},"to":[{"targetRef":{"kind":"Mesh"},"default":{"idleTimeout":"1h","connectionTimeout":"5s"}}]}}`
+	tests := map[string]struct {
+		desired KumaJSONValue
+		want    string
+	}{
+		"no drift keeps the configuration": {
+			desired: NewKumaJSONValue(`{"type": "MeshTimeout", "name": "mt", "mesh": "default", "spec": {"to": [{"targetRef": {"kind": "Mesh"}, "default": {"idleTimeout": "1h"}}]}}`),
+			want:    `{"type": "MeshTimeout", "name": "mt", "mesh": "default", "spec": {"to": [{"targetRef": {"kind": "Mesh"}, "default": {"idleTimeout": "1h"}}]}}`,
+		},
+		"drift only shows managed fields": {
+			desired: NewKumaJSONValue(`{"type":"MeshTimeout","name":"mt","mesh":"default","spec":{"to":[{"targetRef":{"kind":"Mesh"},"default":{"idleTimeout":"2h"}}]}}`),
+			want:    `{"mesh":"default","name":"mt","spec":{"to":[{"default":{"idleTimeout":"1h"},"targetRef":{"kind":"Mesh"}}]},"type":"MeshTimeout"}`,
+		},
+		"import uses the observed resource": {
+			desired: KumaJSONValue{StringValue: basetypes.NewStringNull()},
+			want:    `{"mesh":"default","name":"mt","spec":{"targetRef":{"kind":"Mesh"},"to":[{"default":{"connectionTimeout":"5s","idleTimeout":"1h"},"targetRef":{"kind":"Mesh"}}]},"type":"MeshTimeout"}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := managedJSON(tt.desired, observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.ValueString() != tt.want {
+				t.Errorf("expected %s got %s", tt.want, got.ValueString())
+			}
+		})
+	}
+}
+
+func TestResolveMeta(t *testing.T) {
+	r := &KumaRawResource{metadata: kumaapi.Metadata{Resources: []kumaapi.Resource{{Name: "MeshTimeout", IsMeshed: true}}}}
+	data := KumaMeshedResourceModel{
+		Name:    types.StringUnknown(),
+		Type:    types.StringUnknown(),
+		Mesh:    types.StringUnknown(),
+		RawJson: NewKumaJSONValue(`{"type": "MeshTimeout", "name": "mt", "mesh": "default"}`),
+	}
+	if diags := r.resolveMeta(&data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Name.ValueString() != "mt" || data.Type.ValueString() != "MeshTimeout" || data.Mesh.ValueString() != "default" {
+		t.Errorf("unexpected meta %+v", data)
+	}
+
+	// Known values from the plan are kept.
+	data.RawJson = NewKumaJSONValue(`{"type": "MeshTimeout", "name": "other", "mesh": "default"}`)
+	if diags := r.resolveMeta(&data); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Name.ValueString() != "mt" {
+		t.Errorf("expected the planned name got %s", data.Name.ValueString())
+	}
+}
+
 func TestAccExampleResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -39,6 +141,9 @@ spec:
 					resource.TestCheckResourceAttr("kuma_raw_resource.test", "name", "test-1"),
 					resource.TestCheckResourceAttr("kuma_raw_resource.test", "mesh", "default"),
 					resource.TestCheckResourceAttr("kuma_raw_resource.test", "type", "MeshTrafficPermission"),
+					resource.TestCheckResourceAttrSet("kuma_raw_resource.test", "observed_json"),
+					resource.TestCheckResourceAttrSet("kuma_raw_resource.test", "creation_time"),
+					resource.TestCheckResourceAttrSet("kuma_raw_resource.test", "modification_time"),
 				),
 			},
 			// ImportState testing
@@ -48,6 +153,8 @@ spec:
 				ImportStateVerify:                    true,
 				ImportStateId:                        "default/MeshTrafficPermission/test-1",
 				ImportStateVerifyIdentifierAttribute: "name",
+				// An imported raw_json is the full server view, not the configured fields.
+				ImportStateVerifyIgnore: []string{"raw_json"},
 			},
 			// Update and Read testing
 			{
@@ -83,3 +190,91 @@ YAML
 }
 `, json)
 }
+
+func TestAccGlobalResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a mesh and a policy inside it
+			{
+				Config: localProviderConfig + `
+resource "kuma_raw_resource" "mesh" {
+  raw_json = jsonencode({
+    type = "Mesh"
+    name = "tf-mesh-1"
+  })
+}
+
+resource "kuma_raw_resource" "policy" {
+  raw_json = jsonencode({
+    type = "MeshTrafficPermission"
+    name = "test-1"
+    mesh = kuma_raw_resource.mesh.name
+    spec = {
+      targetRef = { kind = "Mesh" }
+      from = [{
+        targetRef = { kind = "Mesh" }
+        default   = { action = "Allow" }
+      }]
+    }
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_raw_resource.mesh", "name", "tf-mesh-1"),
+					resource.TestCheckNoResourceAttr("kuma_raw_resource.mesh", "mesh"),
+					resource.TestCheckResourceAttr("kuma_raw_resource.mesh", "type", "Mesh"),
+					resource.TestCheckResourceAttr("kuma_raw_resource.policy", "mesh", "tf-mesh-1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "kuma_raw_resource.mesh",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "Mesh/tf-mesh-1",
+				ImportStateVerifyIdentifierAttribute: "name",
+				// An imported raw_json is the full server view, not the configured fields.
+				ImportStateVerifyIgnore: []string{"raw_json"},
+			},
+		},
+	})
+}
+
+func TestAccResourceUnknownAtPlan(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The output of terraform_data is only known once created, so is raw_json.
+				Config: localProviderConfig + `
+resource "terraform_data" "name" {
+  input = "tf-unknown-1"
+}
+
+resource "kuma_raw_resource" "policy" {
+  raw_json = jsonencode({
+    type = "MeshTrafficPermission"
+    name = terraform_data.name.output
+    mesh = "default"
+    spec = {
+      targetRef = { kind = "Mesh" }
+      from = [{
+        targetRef = { kind = "Mesh" }
+        default   = { action = "Allow" }
+      }]
+    }
+  })
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kuma_raw_resource.policy", "name", "tf-unknown-1"),
+					resource.TestCheckResourceAttr("kuma_raw_resource.policy", "mesh", "default"),
+					resource.TestCheckResourceAttr("kuma_raw_resource.policy", "type", "MeshTrafficPermission"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// configureClient extracts the client and the metadata of the control-plane from the provider data.
+func configureClient(ctx context.Context, providerData any) (kumaapi.Client, kumaapi.Metadata, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	data, ok := providerData.(*kumaProviderData)
+	if !ok {
+		diags.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *kumaProviderData, got: %T. Please report this issue to the provider developers.", providerData),
+		)
+		return nil, kumaapi.Metadata{}, diags
+	}
+
+	// The metadata is already cached by the provider.
+	metadata, err := data.metadata.Get(ctx)
+	if err != nil {
+		diags.AddError("failed to heartbeat control-plane", err.Error())
+		return nil, kumaapi.Metadata{}, diags
+	}
+	return data.client, metadata, diags
+}
+
+// resolveResource returns the api path of the resource type and the mesh to use for it (empty for global resources).
+func resolveResource(metadata kumaapi.Metadata, resType string, mesh string) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	res, ok := metadata.LookupResource(resType)
+	if !ok {
+		detail := fmt.Sprintf("Resource type '%s' is not supported by the server", resType)
+		if minVersion, known := resourceVersions[resType]; known {
+			detail = fmt.Sprintf("%s, it requires Kuma >= %s and the control-plane is %s", detail, minVersion, describeControlPlane(metadata))
+		}
+		diags.AddError("unsupported resource type", detail)
+		return "", "", diags
+	}
+	if !res.IsMeshed {
+		return res.Path, "", diags
+	}
+	if mesh == "" {
+		diags.AddError("missing mesh", fmt.Sprintf("Resource type '%s' is scoped to a mesh but no mesh is set", res.Name))
+	}
+	return res.Path, mesh, diags
+}
+
+// createResource creates a resource which must not exist yet and returns it as stored by the control-plane.
+func createResource(ctx context.Context, client kumaapi.Client, mesh string, resourcePath string, name string, body string, attribute func(field string) path.Path) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	res, err := client.FetchResource(ctx, mesh, resourcePath, name)
+	if err != nil {
+		diags.AddError("client Error", fmt.Sprintf("Unable to fetch resource have create, got error: %s", err))
+		return nil, diags
+	}
+	if res != nil {
+		diags.AddError("Unable to Create Resource", "Resource already exists!")
+		return nil, diags
+	}
+	return putResource(ctx, client, mesh, resourcePath, name, body, attribute)
+}
+
+// putResource creates or updates a resource and returns it as stored by the control-plane.
+// The fields rejected by the control-plane are reported on the attribute returned by attribute.
+func putResource(ctx context.Context, client kumaapi.Client, mesh string, resourcePath string, name string, body string, attribute func(field string) path.Path) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	err := client.PutResource(ctx, mesh, resourcePath, name, body)
+	if err != nil {
+		return nil, apiErrorDiagnostics("Unable to create resource", err, attribute)
+	}
+	res, err := client.FetchResource(ctx, mesh, resourcePath, name)
+	if err != nil {
+		diags.AddError("client Error", fmt.Sprintf("Unable to fetch resource after create, got error: %s", err))
+		return nil, diags
+	}
+	if res == nil {
+		diags.AddError("client Error", "Resource didn't exist just after the put")
+		return nil, diags
+	}
+	return res, diags
+}
+
+// validateResource submits a resource to the control-plane in dry-run mode, the fields it rejects are reported on the
+// attribute returned by attribute.
+func validateResource(ctx context.Context, client kumaapi.Client, mesh string, resourcePath string, name string, body string, attribute func(field string) path.Path) diag.Diagnostics {
+	err := client.ValidateResource(ctx, mesh, resourcePath, name, body)
+	if err != nil {
+		return apiErrorDiagnostics("Unable to validate resource", err, attribute)
+	}
+	return nil
+}
+
+// apiErrorDiagnostics reports an error of the client, when the control-plane rejected the resource the error is reported
+// on the attribute returned by attribute for each rejected field.
+func apiErrorDiagnostics(detail string, err error, attribute func(field string) path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var apiErr *kumaapi.APIError
+	switch {
+	case errors.As(err, &apiErr) && len(apiErr.InvalidParameters) > 0:
+		for _, p := range apiErr.InvalidParameters {
+			diags.AddAttributeError(attribute(p.Field), "invalid resource", fmt.Sprintf("The control-plane rejected `%s`: %s", p.Field, p.Reason))
+		}
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest && apiErr.Title != "":
+		diags.AddAttributeError(attribute(""), "invalid resource", fmt.Sprintf("The control-plane rejected the resource: %s %s", apiErr.Title, apiErr.Detail))
+	case errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden):
+		diags.AddError("permission denied", fmt.Sprintf("%s, the control-plane denied the request, check the permissions of the token, got error: %s", detail, err))
+	default:
+		diags.AddError("client Error", fmt.Sprintf("%s, got error: %s", detail, err))
+	}
+	return diags
+}
+
+// deleteResource deletes a resource, a resource which is already gone only results in a warning.
+func deleteResource(ctx context.Context, client kumaapi.Client, mesh string, resourcePath string, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	out, err := client.FetchResource(ctx, mesh, resourcePath, name)
+	if err != nil {
+		diags.AddError("client Error", fmt.Sprintf("Unable to read policy, got error: %s", err))
+		return diags
+	}
+	if out == nil {
+		diags.AddWarning("already deleted", "Resource was already deleted")
+		return diags
+	}
+
+	err = client.DeleteResource(ctx, mesh, resourcePath, name)
+	if err != nil {
+		diags.AddError("delete error", fmt.Sprintf("Unable to delete policy, got error: %s", err))
+	}
+	return diags
+}
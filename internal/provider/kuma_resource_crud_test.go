@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestApiErrorDiagnostics(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want diag.Diagnostics
+	}{
+		"invalid parameters": {
+			err: fmt.Errorf("wrapped: %w", &kumaapi.APIError{
+				Method:     http.MethodPut,
+				Path:       "/meshes/default/meshtimeouts/mt",
+				StatusCode: http.StatusBadRequest,
+				Title:      "Invalid request",
+				InvalidParameters: []kumaapi.InvalidParameter{
+					{Field: "spec.to[0].default.idleTimeout", Reason: "must be a valid duration"},
+					{Field: "spec.targetRef", Reason: "must be defined"},
+				},
+			}),
+			want: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("raw_json"), "invalid resource", "The control-plane rejected `spec.to[0].default.idleTimeout`: must be a valid duration"),
+				diag.NewAttributeErrorDiagnostic(path.Root("raw_json"), "invalid resource", "The control-plane rejected `spec.targetRef`: must be defined"),
+			},
+		},
+		"bad request without fields": {
+			err: &kumaapi.APIError{Method: http.MethodPut, Path: "/meshes/m", StatusCode: http.StatusBadRequest, Title: "Invalid request", Detail: "mesh is invalid"},
+			want: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("raw_json"), "invalid resource", "The control-plane rejected the resource: Invalid request mesh is invalid"),
+			},
+		},
+		"missing permission": {
+			err: &kumaapi.APIError{Method: http.MethodPut, Path: "/meshes/m", StatusCode: http.StatusForbidden, Title: "Forbidden"},
+			want: diag.Diagnostics{
+				diag.NewErrorDiagnostic("permission denied", "Unable to create resource, the control-plane denied the request, check the permissions of the token, got error: invalid http response '403 Forbidden' for PUT '/meshes/m' request: Forbidden"),
+			},
+		},
+		"other error": {
+			err: errors.New("connection refused"),
+			want: diag.Diagnostics{
+				diag.NewErrorDiagnostic("client Error", "Unable to create resource, got error: connection refused"),
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := apiErrorDiagnostics("Unable to create resource", tt.err, rawJsonPath)
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %v got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KumaResourceDataSource{}
+var _ datasource.DataSourceWithConfigure = &KumaResourceDataSource{}
+
+func NewKumaResourceDataSource() datasource.DataSource {
+	return &KumaResourceDataSource{}
+}
+
+// KumaResourceDataSource reads any existing resource of the control-plane.
+type KumaResourceDataSource struct {
+	client   kumaapi.Client
+	metadata kumaapi.Metadata
+}
+
+// KumaResourceDataSourceModel describes the data source data model.
+type KumaResourceDataSourceModel struct {
+	Type types.String `tfsdk:"type"`
+	Name types.String `tfsdk:"name"`
+	Mesh types.String `tfsdk:"mesh"`
+	// RawJson is the resource as returned by the control-plane, timestamps excluded.
+	RawJson          types.String `tfsdk:"raw_json"`
+	Labels           types.Map    `tfsdk:"labels"`
+	CreationTime     types.String `tfsdk:"creation_time"`
+	ModificationTime types.String `tfsdk:"modification_time"`
+}
+
+func (d *KumaResourceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource"
+}
+
+func (d *KumaResourceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads any existing resource of the control-plane, e.g. to reference a resource managed outside of this configuration without importing it.",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the resource (e.g. `Mesh`, `MeshService` or `MeshTrafficPermission`)",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the resource",
+				Required:            true,
+			},
+			"mesh": schema.StringAttribute{
+				MarkdownDescription: "The mesh the resource is part of, required for resources scoped to a mesh and unset for global resources like `Mesh` or `Zone`",
+				Optional:            true,
+			},
+			"raw_json": schema.StringAttribute{
+				MarkdownDescription: "The resource as returned by the control-plane, without its timestamps. Use `jsondecode` to access its fields",
+				Computed:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "The labels of the resource, including the ones added by the control-plane (e.g. `kuma.io/origin`)",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"creation_time": schema.StringAttribute{
+				MarkdownDescription: "The time the resource was created in the control-plane",
+				Computed:            true,
+			},
+			"modification_time": schema.StringAttribute{
+				MarkdownDescription: "The time the resource was last modified in the control-plane",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *KumaResourceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, metadata, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = client
+	d.metadata = metadata
+}
+
+func (d *KumaResourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KumaResourceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if res, ok := d.metadata.LookupResource(data.Type.ValueString()); ok && !res.IsMeshed && !data.Mesh.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("mesh"), "unexpected mesh", fmt.Sprintf("Resource type '%s' is global, `mesh` must not be set", res.Name))
+		return
+	}
+	resourcePath, mesh, diags := resolveResource(d.metadata, data.Type.ValueString(), data.Mesh.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	res, err := d.client.FetchResource(ctx, mesh, resourcePath, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Unable to read resource", err, func(string) path.Path { return path.Root("name") })...)
+		return
+	}
+	if res == nil {
+		resp.Diagnostics.AddError("resource not found", fmt.Sprintf("%s `%s` doesn't exist%s", data.Type.ValueString(), data.Name.ValueString(), inMesh(mesh)))
+		return
+	}
+	resp.Diagnostics.Append(data.setResource(ctx, res)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setResource stores the resource returned by the control-plane in the computed attributes.
+func (m *KumaResourceDataSourceModel) setResource(ctx context.Context, res []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+	observed, err := decodeObserved(res)
+	if err != nil {
+		diags.AddError("client Error", fmt.Sprintf("Failed to decode resource, got error: %s", err))
+		return diags
+	}
+	m.CreationTime = observed.creationTime
+	m.ModificationTime = observed.modificationTime
+
+	labels := map[string]string{}
+	if v, ok := observed.doc["labels"].(map[string]interface{}); ok {
+		for k, l := range v {
+			labels[k] = fmt.Sprint(l)
+		}
+	}
+	var d diag.Diagnostics
+	m.Labels, d = types.MapValueFrom(ctx, types.StringType, labels)
+	diags.Append(d...)
+
+	m.RawJson = types.StringValue(observed.json)
+	return diags
+}
+
+// inMesh describes the mesh of a resource in messages, it's empty for global resources.
+func inMesh(mesh string) string {
+	if mesh == "" {
+		return ""
+	}
+	return fmt.Sprintf(" in mesh `%s`", mesh)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestResourceDataSourceSetResource(t *testing.T) {
+	var data KumaResourceDataSourceModel
+	res := `{"type":"Mesh","name":"default","creationTime":"2024-01-01T00:00:00Z","modificationTime":"2024-01-02T00:00:00Z","labels":{"team":"core"},"mtls":{"enabledBackend":"ca-1","backends":[{"name":"ca-1","type":"builtin"}]}}`
+	if diags := data.setResource(context.Background(), []byte(res)); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := `{"labels":{"team":"core"},"mtls":{"backends":[{"name":"ca-1","type":"builtin"}],"enabledBackend":"ca-1"},"name":"default","type":"Mesh"}`
+	if data.RawJson.ValueString() != want {
+		t.Errorf("expected raw_json %s got %s", want, data.RawJson.ValueString())
+	}
+	if data.CreationTime.ValueString() != "2024-01-01T00:00:00Z" || data.ModificationTime.ValueString() != "2024-01-02T00:00:00Z" {
+		t.Errorf("unexpected times %s %s", data.CreationTime, data.ModificationTime)
+	}
+	wantLabels, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{"team": "core"})
+	if !data.Labels.Equal(wantLabels) {
+		t.Errorf("expected labels %s got %s", wantLabels, data.Labels)
+	}
+}
+
+func TestAccResourceDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: localProviderConfig + `
+resource "kuma_raw_resource" "policy" {
+  raw_json = jsonencode({
+    type = "MeshTrafficPermission"
+    name = "tf-ds-1"
+    mesh = "default"
+    labels = { team = "core" }
+    spec = {
+      targetRef = { kind = "Mesh" }
+      from = [{
+        targetRef = { kind = "Mesh" }
+        default   = { action = "Allow" }
+      }]
+    }
+  })
+}
+
+data "kuma_resource" "policy" {
+  type = "MeshTrafficPermission"
+  mesh = "default"
+  name = kuma_raw_resource.policy.name
+}
+
+data "kuma_resource" "mesh" {
+  type = "Mesh"
+  name = "default"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kuma_resource.policy", "labels.team", "core"),
+					resource.TestCheckResourceAttrSet("data.kuma_resource.policy", "raw_json"),
+					resource.TestCheckResourceAttrSet("data.kuma_resource.policy", "creation_time"),
+					resource.TestCheckResourceAttrSet("data.kuma_resource.mesh", "raw_json"),
+					resource.TestCheckNoResourceAttr("data.kuma_resource.mesh", "mesh"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KumaResourcesDataSource{}
+var _ datasource.DataSourceWithConfigure = &KumaResourcesDataSource{}
+
+func NewKumaResourcesDataSource() datasource.DataSource {
+	return &KumaResourcesDataSource{}
+}
+
+// KumaResourcesDataSource lists the resources of a type.
+type KumaResourcesDataSource struct {
+	client   kumaapi.Client
+	metadata kumaapi.Metadata
+}
+
+// KumaResourcesDataSourceModel describes the data source data model.
+type KumaResourcesDataSourceModel struct {
+	Type         types.String                  `tfsdk:"type"`
+	Mesh         types.String                  `tfsdk:"mesh"`
+	NameContains types.String                  `tfsdk:"name_contains"`
+	Labels       types.Map                     `tfsdk:"labels"`
+	PageSize     types.Int64                   `tfsdk:"page_size"`
+	Items        []KumaResourceDataSourceModel `tfsdk:"items"`
+}
+
+func (d *KumaResourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resources"
+}
+
+func (d *KumaResourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The type of the resources (e.g. `MeshService` or `Dataplane`)",
+			Required:            true,
+		},
+		"mesh": schema.StringAttribute{
+			MarkdownDescription: "The mesh to list the resources of, resources scoped to a mesh are listed in all meshes when unset. Must be unset for global resources like `Mesh` or `Zone`",
+			Optional:            true,
+		},
+		"items": schema.ListNestedAttribute{
+			MarkdownDescription: "The resources, in the order returned by the control-plane",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the resource",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the resource",
+						Computed:            true,
+					},
+					"mesh": schema.StringAttribute{
+						MarkdownDescription: "The mesh of the resource, unset for global resources",
+						Computed:            true,
+					},
+					"raw_json": schema.StringAttribute{
+						MarkdownDescription: "The resource as returned by the control-plane, without its timestamps",
+						Computed:            true,
+					},
+					"labels": schema.MapAttribute{
+						MarkdownDescription: "The labels of the resource",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"creation_time": schema.StringAttribute{
+						MarkdownDescription: "The time the resource was created in the control-plane",
+						Computed:            true,
+					},
+					"modification_time": schema.StringAttribute{
+						MarkdownDescription: "The time the resource was last modified in the control-plane",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+	for k, v := range listFilterAttributes("resources") {
+		attributes[k] = v
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the resources of a type, e.g. to use every `MeshService` of a mesh in a `for_each`.",
+		Attributes:          attributes,
+	}
+}
+
+// listFilterAttributes returns the attributes filtering the items of a list data source, what describes the items.
+func listFilterAttributes(what string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name_contains": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Only list the %s whose name contains this value", what),
+			Optional:            true,
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: fmt.Sprintf("Only list the %s having all these labels", what),
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"page_size": schema.Int64Attribute{
+			MarkdownDescription: fmt.Sprintf("The number of %s requested per page, all the pages are read. Defaults to the page size of the control-plane", what),
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+// listFilters converts the attributes of listFilterAttributes to the filters of the client.
+func listFilters(ctx context.Context, nameContains types.String, labels types.Map, pageSize types.Int64) (kumaapi.ListFilters, diag.Diagnostics) {
+	filters := kumaapi.ListFilters{
+		Size:         int(pageSize.ValueInt64()),
+		NameContains: nameContains.ValueString(),
+	}
+	diags := labels.ElementsAs(ctx, &filters.Labels, false)
+	return filters, diags
+}
+
+func (d *KumaResourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, metadata, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = client
+	d.metadata = metadata
+}
+
+func (d *KumaResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KumaResourcesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, ok := d.metadata.LookupResource(data.Type.ValueString())
+	if !ok {
+		// Reuse the message of the other resources.
+		_, _, diags := resolveResource(d.metadata, data.Type.ValueString(), "")
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if !res.IsMeshed && !data.Mesh.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("mesh"), "unexpected mesh", fmt.Sprintf("Resource type '%s' is global, `mesh` must not be set", res.Name))
+		return
+	}
+	filters, diags := listFilters(ctx, data.NameContains, data.Labels, data.PageSize)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := listResources(ctx, d.client, data.Mesh.ValueString(), res.Path, filters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Items = items
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listResources lists the resources matching the filters and converts them to the model of the `kuma_resource` data source.
+func listResources(ctx context.Context, client kumaapi.Client, mesh string, resourcePath string, filters kumaapi.ListFilters) ([]KumaResourceDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	list, err := client.ListResources(ctx, mesh, resourcePath, filters)
+	if err != nil {
+		return nil, apiErrorDiagnostics("Unable to list resources", err, func(string) path.Path { return path.Root("type") })
+	}
+	items := make([]KumaResourceDataSourceModel, 0, len(list))
+	for _, res := range list {
+		meta := struct {
+			Type string `json:"type"`
+			Name string `json:"name"`
+			Mesh string `json:"mesh"`
+		}{}
+		if err := json.Unmarshal(res, &meta); err != nil {
+			diags.AddError("client Error", fmt.Sprintf("Failed to decode resource, got error: %s", err))
+			return nil, diags
+		}
+		item := KumaResourceDataSourceModel{
+			Type: types.StringValue(meta.Type),
+			Name: types.StringValue(meta.Name),
+			Mesh: types.StringNull(),
+		}
+		if meta.Mesh != "" {
+			item.Mesh = types.StringValue(meta.Mesh)
+		}
+		diags.Append(item.setResource(ctx, res)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		items = append(items, item)
+	}
+	return items, diags
+}
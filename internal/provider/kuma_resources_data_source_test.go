@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestListResources(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"total": 2, "items": [
+			{"type": "MeshService", "name": "web", "mesh": "default", "labels": {"team": "core"}, "creationTime": "2024-01-01T00:00:00Z"},
+			{"type": "MeshService", "name": "api", "mesh": "other"}
+		], "next": null}`))
+	}))
+	defer srv.Close()
+
+	items, diags := listResources(context.Background(), kumaapi.NewClient(srv.URL, ""), "", "meshservices", kumaapi.ListFilters{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items got %d", len(items))
+	}
+	if !items[0].Name.Equal(types.StringValue("web")) || !items[0].Mesh.Equal(types.StringValue("default")) || !items[0].Type.Equal(types.StringValue("MeshService")) {
+		t.Errorf("unexpected item %+v", items[0])
+	}
+	if items[0].CreationTime.ValueString() != "2024-01-01T00:00:00Z" || len(items[0].Labels.Elements()) != 1 {
+		t.Errorf("unexpected item %+v", items[0])
+	}
+	if !items[1].Mesh.Equal(types.StringValue("other")) || !items[1].CreationTime.IsNull() {
+		t.Errorf("unexpected item %+v", items[1])
+	}
+}
+
+func TestAccResourcesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: localProviderConfig + `
+resource "kuma_raw_resource" "policy" {
+  for_each = toset(["tf-list-1", "tf-list-2"])
+  raw_json = jsonencode({
+    type = "MeshTrafficPermission"
+    name = each.key
+    mesh = "default"
+    labels = { team = "tf-list" }
+    spec = {
+      targetRef = { kind = "Mesh" }
+      from = [{
+        targetRef = { kind = "Mesh" }
+        default   = { action = "Allow" }
+      }]
+    }
+  })
+}
+
+data "kuma_resources" "policies" {
+  type      = "MeshTrafficPermission"
+  mesh      = "default"
+  labels    = { team = "tf-list" }
+  page_size = 1
+
+  depends_on = [kuma_raw_resource.policy]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kuma_resources.policies", "items.#", "2"),
+					resource.TestCheckResourceAttr("data.kuma_resources.policies", "items.0.mesh", "default"),
+					resource.TestCheckResourceAttr("data.kuma_resources.policies", "items.0.labels.team", "tf-list"),
+				),
+			},
+		},
+	})
+}
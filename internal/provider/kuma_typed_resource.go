@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KumaTypedResource{}
+var _ resource.ResourceWithImportState = &KumaTypedResource{}
+var _ resource.ResourceWithModifyPlan = &KumaTypedResource{}
+
+// importedKey is set in the private state of imported resources so the first read takes everything from the server.
+const importedKey = "imported"
+
+// typedResourceDefinition describes a kuma resource type exposed with a typed schema.
+type typedResourceDefinition struct {
+	// typeName is the suffix of the terraform resource type (e.g. `mesh` for `kuma_mesh`).
+	typeName string
+	// kumaType is the type of the resource in kuma (e.g. `Mesh`).
+	kumaType            string
+	meshed              bool
+	markdownDescription string
+	// attributes are the attributes of the resource besides `name`, `mesh` and `labels`.
+	attributes map[string]schema.Attribute
+	// jsonNames holds the json keys which aren't the camelCase version of the attribute name.
+	jsonNames map[string]string
+	// toKuma and fromKuma adapt the json of fields which don't map one to one with the schema.
+	toKuma   func(map[string]interface{})
+	fromKuma func(map[string]interface{}) error
+}
+
+func newKumaTypedResource(definition typedResourceDefinition) func() resource.Resource {
+	return func() resource.Resource {
+		return &KumaTypedResource{definition: definition}
+	}
+}
+
+// KumaTypedResource is a resource whose schema matches the schema of a kuma resource type.
+type KumaTypedResource struct {
+	definition typedResourceDefinition
+	client     kumaapi.Client
+	metadata   kumaapi.Metadata
+}
+
+func (r *KumaTypedResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.definition.typeName
+}
+
+func (r *KumaTypedResource) attributes() map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The name of the %s", r.definition.kumaType),
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"labels": schema.MapAttribute{
+			MarkdownDescription: "Labels to set on the resource, labels added by the control-plane are ignored",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+	}
+	if r.definition.meshed {
+		attributes["mesh"] = schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The mesh the %s is part of", r.definition.kumaType),
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+	for k, v := range r.definition.attributes {
+		attributes[k] = v
+	}
+	return attributes
+}
+
+func (r *KumaTypedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: r.definition.markdownDescription,
+		Attributes:          r.attributes(),
+	}
+}
+
+func (r *KumaTypedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, metadata, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client = client
+	r.metadata = metadata
+}
+
+func (r *KumaTypedResource) converter() jsonConverter {
+	return jsonConverter{names: r.definition.jsonNames}
+}
+
+// toJSON converts the terraform value of the resource to the json sent to kuma.
+func (r *KumaTypedResource) toJSON(v tftypes.Value) (string, error) {
+	m, err := r.converter().objectToJSON(r.attributes(), v)
+	if err != nil {
+		return "", err
+	}
+	if r.definition.toKuma != nil {
+		r.definition.toKuma(m)
+	}
+	m["type"] = r.definition.kumaType
+	out, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// fromJSON converts the resource returned by kuma to its terraform value keeping only the fields managed in prior.
+func (r *KumaTypedResource) fromJSON(ctx context.Context, data []byte, prior tftypes.Value) (tftypes.Value, error) {
+	server := map[string]interface{}{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&server); err != nil {
+		return tftypes.Value{}, fmt.Errorf("fail unmarshalling: %w", err)
+	}
+	if r.definition.fromKuma != nil {
+		if err := r.definition.fromKuma(server); err != nil {
+			return tftypes.Value{}, err
+		}
+	}
+	var managed interface{} = server
+	if !prior.IsNull() {
+		p, err := r.converter().objectToJSON(r.attributes(), prior)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		managed = onlyManagedFields(server, p)
+	} else {
+		// Without prior value (import) every field is taken from the server but the labels added by the control-plane.
+		dropSystemLabels(server)
+	}
+	m, _ := managed.(map[string]interface{})
+	return r.converter().objectFromJSON(ctx, r.attributes(), m)
+}
+
+// target returns the api path, mesh and name of the resource described by v.
+func (r *KumaTypedResource) target(v tftypes.Value) (string, string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	values := map[string]tftypes.Value{}
+	var name, mesh string
+	err := v.As(&values)
+	if err == nil {
+		err = values["name"].As(&name)
+	}
+	if err == nil && r.definition.meshed {
+		err = values["mesh"].As(&mesh)
+	}
+	if err != nil {
+		diags.AddError("invalid resource", fmt.Sprintf("Failed to extract name and mesh, got error: %s", err))
+		return "", "", "", diags
+	}
+	resourcePath, mesh, diags := resolveResource(r.metadata, r.definition.kumaType, mesh)
+	return resourcePath, mesh, name, diags
+}
+
+func (r *KumaTypedResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip when deleting, when nothing changes and when some values are only known at apply.
+	if req.Plan.Raw.IsNull() || r.client == nil || !req.Plan.Raw.IsFullyKnown() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	body, err := r.toJSON(req.Plan.Raw)
+	if err != nil {
+		// Reported when applying.
+		return
+	}
+	doc := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(body), &doc); err == nil {
+		resp.Diagnostics.Append(checkFeatures(r.metadata, r.definition.kumaType, doc, r.attributePath)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// A resource type unknown to the control-plane is reported during plan rather than failing the apply.
+	resourcePath, mesh, name, diags := r.target(req.Plan.Raw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateResource(ctx, r.client, mesh, resourcePath, name, body, r.attributePath)...)
+}
+
+// attributePath returns the path of the attribute matching a field reported by kuma (e.g. `spec.from[0].default.action`).
+// It stops at the deepest attribute found.
+func (r *KumaTypedResource) attributePath(field string) path.Path {
+	out := path.Empty()
+	attributes := r.attributes()
+	for _, segment := range strings.Split(field, ".") {
+		key, index, hasIndex := strings.Cut(segment, "[")
+		var found schema.Attribute
+		for name, a := range attributes {
+			if r.converter().jsonName(name) == key {
+				out = out.AtName(name)
+				found = a
+				break
+			}
+		}
+		switch a := found.(type) {
+		case schema.SingleNestedAttribute:
+			attributes = a.Attributes
+			continue
+		case schema.ListNestedAttribute:
+			i, err := strconv.Atoi(strings.TrimSuffix(index, "]"))
+			if hasIndex && err == nil {
+				out = out.AtListIndex(i)
+				attributes = a.NestedObject.Attributes
+				continue
+			}
+		}
+		return out
+	}
+	return out
+}
+
+func (r *KumaTypedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resourcePath, mesh, name, diags := r.target(req.Plan.Raw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	body, err := r.toJSON(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("invalid resource", fmt.Sprintf("Failed to convert to json, got error: %s", err))
+		return
+	}
+	_, diags = createResource(ctx, r.client, mesh, resourcePath, name, body, r.attributePath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (r *KumaTypedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resourcePath, mesh, name, diags := r.target(req.State.Raw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	res, err := r.client.FetchResource(ctx, mesh, resourcePath, name)
+	if err != nil {
+		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Unable to read resource, got error: %s", err))
+		return
+	}
+	if res == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	prior := req.State.Raw
+	imported, diags := req.Private.GetKey(ctx, importedKey)
+	resp.Diagnostics.Append(diags...)
+	if string(imported) == "true" {
+		prior = tftypes.NewValue(prior.Type(), nil)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, []byte("false"))...)
+	}
+	v, err := r.fromJSON(ctx, res, prior)
+	if err != nil {
+		resp.Diagnostics.AddError("client Error", fmt.Sprintf("Failed to convert resource, got error: %s", err))
+		return
+	}
+	resp.State.Raw = v
+}
+
+func (r *KumaTypedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resourcePath, mesh, name, diags := r.target(req.Plan.Raw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	body, err := r.toJSON(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("invalid resource", fmt.Sprintf("Failed to convert to json, got error: %s", err))
+		return
+	}
+	_, diags = putResource(ctx, r.client, mesh, resourcePath, name, body, r.attributePath)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (r *KumaTypedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resourcePath, mesh, name, diags := r.target(req.State.Raw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(deleteResource(ctx, r.client, mesh, resourcePath, name)...)
+}
+
+func (r *KumaTypedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(strings.Trim(req.ID, "/"), "/")
+	switch {
+	case r.definition.meshed && len(parts) == 2:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mesh"), parts[0])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+	case !r.definition.meshed && len(parts) == 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[0])...)
+	case r.definition.meshed:
+		resp.Diagnostics.AddError("bad request", "the id of the resource must be of the format: `<mesh>/<name>`.")
+		return
+	default:
+		resp.Diagnostics.AddError("bad request", "the id of the resource must be of the format: `<name>`.")
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedKey, []byte("true"))...)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTypedResourceAttributePath(t *testing.T) {
+	r := NewKumaMeshLoadBalancingStrategyResource().(*KumaTypedResource)
+	tests := map[string]path.Path{
+		"spec.to[0].default.hashPolicies[1].connection.sourceIP": path.Root("spec").AtName("to").AtListIndex(0).AtName("default").AtName("hash_policies").AtListIndex(1).AtName("connection").AtName("source_ip"),
+		"spec.targetRef.kind":  path.Root("spec").AtName("target_ref").AtName("kind"),
+		"spec.to[0].unknown.a": path.Root("spec").AtName("to").AtListIndex(0),
+		"name":                 path.Root("name"),
+		"":                     path.Empty(),
+	}
+	for field, want := range tests {
+		if got := r.attributePath(field); !got.Equal(want) {
+			t.Errorf("attributePath(%q) = %s, want %s", field, got, want)
+		}
+	}
+}
+
+func TestTypedResourceFromJSONImport(t *testing.T) {
+	r := NewKumaMeshTrafficPermissionResource().(*KumaTypedResource)
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx)
+	tests := map[string]struct {
+		labels string
+		want   map[string]string
+	}{
+		"system labels only": {
+			labels: `{"kuma.io/mesh": "default", "kuma.io/origin": "global", "kuma.io/policy-role": "system"}`,
+		},
+		"user labels": {
+			labels: `{"kuma.io/mesh": "default", "kuma.io/zone": "east", "team": "payments"}`,
+			want:   map[string]string{"team": "payments"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			data := `{"type": "MeshTrafficPermission", "mesh": "default", "name": "mtp", "labels": ` + tt.labels + `, "spec": {"targetRef": {"kind": "Mesh"}}}`
+			v, err := r.fromJSON(ctx, []byte(data), tftypes.NewValue(typ, nil))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			values := map[string]tftypes.Value{}
+			if err := v.As(&values); err != nil {
+				t.Fatal(err)
+			}
+			if tt.want == nil {
+				if !values["labels"].IsNull() {
+					t.Errorf("expected null labels got %s", values["labels"])
+				}
+				return
+			}
+			labels := map[string]tftypes.Value{}
+			if err := values["labels"].As(&labels); err != nil {
+				t.Fatal(err)
+			}
+			got := map[string]string{}
+			for k, l := range labels {
+				var s string
+				_ = l.As(&s)
+				got[k] = s
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTypedResourceModifyPlanUnsupportedType(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]struct {
+		metadata kumaapi.Metadata
+		want     string
+	}{
+		"older control-plane": {
+			metadata: kumaapi.Metadata{Product: "Kuma", Version: "2.8.0"},
+			want:     "MeshTLS requires Kuma >= 2.9.0, the control-plane is Kuma 2.8.0",
+		},
+		"unknown version": {
+			metadata: kumaapi.Metadata{Product: "Kuma", Version: "dev-1a2b3c"},
+			want:     "Resource type 'MeshTLS' is not supported by the server",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewKumaMeshTLSResource().(*KumaTypedResource)
+			r.client = kumaapi.NewClient("http://127.0.0.1:0", "")
+			r.metadata = tt.metadata
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			for attribute, attributeType := range typ.AttributeTypes {
+				values[attribute] = tftypes.NewValue(attributeType, nil)
+			}
+			values["mesh"] = tftypes.NewValue(tftypes.String, "default")
+			values["name"] = tftypes.NewValue(tftypes.String, "mtls")
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, values)}
+			req := resource.ModifyPlanRequest{
+				Plan:  plan,
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+
+			r.ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.ErrorsCount() != 1 || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.want) {
+				t.Errorf("expected an error containing %q got %v", tt.want, resp.Diagnostics)
+			}
+		})
+	}
+}
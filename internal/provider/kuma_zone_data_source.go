@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KumaZoneDataSource{}
+var _ datasource.DataSourceWithConfigure = &KumaZoneDataSource{}
+
+func NewKumaZoneDataSource() datasource.DataSource {
+	return &KumaZoneDataSource{}
+}
+
+// KumaZoneDataSource reads a zone and its insight.
+type KumaZoneDataSource struct {
+	client kumaapi.Client
+}
+
+// KumaZoneDataSourceModel describes the data source data model.
+type KumaZoneDataSourceModel struct {
+	Name             types.String  `tfsdk:"name"`
+	Enabled          types.Bool    `tfsdk:"enabled"`
+	Online           types.Bool    `tfsdk:"online"`
+	LastConnectTime  types.String  `tfsdk:"last_connect_time"`
+	Version          types.String  `tfsdk:"version"`
+	GlobalCompatible types.Bool    `tfsdk:"global_compatible"`
+	Environment      types.String  `tfsdk:"environment"`
+	KDS              *KumaKDSModel `tfsdk:"kds"`
+}
+
+// KumaKDSModel describes the stats of the connection of a zone to the global control-plane.
+type KumaKDSModel struct {
+	Subscriptions         types.Int64  `tfsdk:"subscriptions"`
+	ResponsesSent         types.Int64  `tfsdk:"responses_sent"`
+	ResponsesAcknowledged types.Int64  `tfsdk:"responses_acknowledged"`
+	ResponsesRejected     types.Int64  `tfsdk:"responses_rejected"`
+	LastUpdateTime        types.String `tfsdk:"last_update_time"`
+}
+
+func (d *KumaZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone"
+}
+
+func (d *KumaZoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := zoneDataSourceAttributes()
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the zone",
+		Required:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a zone of a multi-zone deployment and its connection to the global control-plane, the provider must use the global control-plane. " +
+			"See the [multi-zone documentation](https://kuma.io/docs/latest/production/deployment/multi-zone/).",
+		Attributes: attributes,
+	}
+}
+
+// zoneDataSourceAttributes returns the computed attributes of a zone, `name` excluded.
+func zoneDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the zone is enabled, the global control-plane doesn't sync resources with a disabled zone",
+			Computed:            true,
+		},
+		"online": schema.BoolAttribute{
+			MarkdownDescription: "Whether the zone is enabled and its control-plane is connected to the global control-plane",
+			Computed:            true,
+		},
+		"last_connect_time": schema.StringAttribute{
+			MarkdownDescription: "The time the zone control-plane last connected to the global control-plane, unset if it never connected",
+			Computed:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "The version of the zone control-plane, unset if it never connected",
+			Computed:            true,
+		},
+		"global_compatible": schema.BoolAttribute{
+			MarkdownDescription: "Whether the version of the zone control-plane is compatible with the global control-plane",
+			Computed:            true,
+		},
+		"environment": schema.StringAttribute{
+			MarkdownDescription: "The environment of the zone control-plane: `kubernetes` or `universal`, unset if it never connected",
+			Computed:            true,
+		},
+		"kds": schema.SingleNestedAttribute{
+			MarkdownDescription: "The stats of the Kuma Discovery Service (KDS) connection syncing resources between the zone and the global control-plane",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"subscriptions": schema.Int64Attribute{
+					MarkdownDescription: "The number of connections of the zone control-plane recorded by the global control-plane",
+					Computed:            true,
+				},
+				"responses_sent": schema.Int64Attribute{
+					MarkdownDescription: "The number of responses sent to the zone on the last connection",
+					Computed:            true,
+				},
+				"responses_acknowledged": schema.Int64Attribute{
+					MarkdownDescription: "The number of responses acknowledged by the zone on the last connection",
+					Computed:            true,
+				},
+				"responses_rejected": schema.Int64Attribute{
+					MarkdownDescription: "The number of responses rejected by the zone on the last connection",
+					Computed:            true,
+				},
+				"last_update_time": schema.StringAttribute{
+					MarkdownDescription: "The time of the last update sent on the last connection",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func (d *KumaZoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, _, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *KumaZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KumaZoneDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := d.client.ZoneOverview(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Unable to read zone", err, func(string) path.Path { return path.Root("name") })...)
+		return
+	}
+	if zone == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "zone not found",
+			fmt.Sprintf("Zone `%s` doesn't exist, zones are only known by the global control-plane", data.Name.ValueString()))
+		return
+	}
+	data.setZone(*zone)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setZone stores the zone returned by the control-plane in the computed attributes.
+func (m *KumaZoneDataSourceModel) setZone(zone kumaapi.ZoneOverview) {
+	m.Name = types.StringValue(zone.Name)
+	m.Enabled = types.BoolValue(zone.Enabled())
+	m.Online = types.BoolValue(zone.Online())
+	m.LastConnectTime = types.StringNull()
+	m.Version = types.StringNull()
+	m.GlobalCompatible = types.BoolValue(false)
+	m.Environment = types.StringNull()
+	m.KDS = &KumaKDSModel{
+		Subscriptions:         types.Int64Value(int64(len(zone.ZoneInsight.Subscriptions))),
+		ResponsesSent:         types.Int64Value(0),
+		ResponsesAcknowledged: types.Int64Value(0),
+		ResponsesRejected:     types.Int64Value(0),
+		LastUpdateTime:        types.StringNull(),
+	}
+	s := zone.LastSubscription()
+	if s == nil {
+		return
+	}
+	m.LastConnectTime = timeValue(s.ConnectTime)
+	if s.Version.KumaCp.Version != "" {
+		m.Version = types.StringValue(s.Version.KumaCp.Version)
+	}
+	m.GlobalCompatible = types.BoolValue(s.Version.KumaCp.KumaCpGlobalCompatible)
+	if env := zone.Environment(); env != "" {
+		m.Environment = types.StringValue(env)
+	}
+	m.KDS.ResponsesSent = types.Int64Value(int64(s.Status.Total.ResponsesSent))
+	m.KDS.ResponsesAcknowledged = types.Int64Value(int64(s.Status.Total.ResponsesAcknowledged))
+	m.KDS.ResponsesRejected = types.Int64Value(int64(s.Status.Total.ResponsesRejected))
+	m.KDS.LastUpdateTime = timeValue(s.Status.LastUpdateTime)
+}
+
+// timeValue formats a time of the control-plane as RFC 3339, unset times are null.
+func timeValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339Nano))
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestZoneDataSourceSetZone(t *testing.T) {
+	tests := map[string]struct {
+		zone string
+		want KumaZoneDataSourceModel
+	}{
+		"online": {
+			zone: `{"name": "zone-1", "zone": {"enabled": true}, "zoneInsight": {"subscriptions": [{
+				"connectTime": "2024-01-02T00:00:01Z",
+				"config": "{\"environment\":\"kubernetes\"}",
+				"version": {"kumaCp": {"version": "2.7.1", "kumaCpGlobalCompatible": true}},
+				"status": {"lastUpdateTime": "2024-01-02T00:00:02Z", "total": {"responsesSent": "12", "responsesAcknowledged": "11", "responsesRejected": "1"}}
+			}]}}`,
+			want: KumaZoneDataSourceModel{
+				Name:             types.StringValue("zone-1"),
+				Enabled:          types.BoolValue(true),
+				Online:           types.BoolValue(true),
+				LastConnectTime:  types.StringValue("2024-01-02T00:00:01Z"),
+				Version:          types.StringValue("2.7.1"),
+				GlobalCompatible: types.BoolValue(true),
+				Environment:      types.StringValue("kubernetes"),
+				KDS: &KumaKDSModel{
+					Subscriptions:         types.Int64Value(1),
+					ResponsesSent:         types.Int64Value(12),
+					ResponsesAcknowledged: types.Int64Value(11),
+					ResponsesRejected:     types.Int64Value(1),
+					LastUpdateTime:        types.StringValue("2024-01-02T00:00:02Z"),
+				},
+			},
+		},
+		"never connected": {
+			zone: `{"name": "zone-2", "zone": {"enabled": false}}`,
+			want: KumaZoneDataSourceModel{
+				Name:             types.StringValue("zone-2"),
+				Enabled:          types.BoolValue(false),
+				Online:           types.BoolValue(false),
+				LastConnectTime:  types.StringNull(),
+				Version:          types.StringNull(),
+				GlobalCompatible: types.BoolValue(false),
+				Environment:      types.StringNull(),
+				KDS: &KumaKDSModel{
+					Subscriptions:         types.Int64Value(0),
+					ResponsesSent:         types.Int64Value(0),
+					ResponsesAcknowledged: types.Int64Value(0),
+					ResponsesRejected:     types.Int64Value(0),
+					LastUpdateTime:        types.StringNull(),
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			zone := kumaapi.ZoneOverview{}
+			if err := json.Unmarshal([]byte(tt.zone), &zone); err != nil {
+				t.Fatal(err)
+			}
+			var got KumaZoneDataSourceModel
+			got.setZone(zone)
+			if !got.Name.Equal(tt.want.Name) || !got.Enabled.Equal(tt.want.Enabled) || !got.Online.Equal(tt.want.Online) ||
+				!got.LastConnectTime.Equal(tt.want.LastConnectTime) || !got.Version.Equal(tt.want.Version) ||
+				!got.GlobalCompatible.Equal(tt.want.GlobalCompatible) || !got.Environment.Equal(tt.want.Environment) {
+				t.Errorf("expected %+v got %+v", tt.want, got)
+			}
+			if *got.KDS != *tt.want.KDS {
+				t.Errorf("expected kds %+v got %+v", *tt.want.KDS, *got.KDS)
+			}
+		})
+	}
+}
+
+func TestAccZonesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The local control-plane is standalone, it has no zones.
+				Config: localProviderConfig + `
+data "kuma_zones" "zones" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kuma_zones.zones", "items.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// zoneTokenScopes are the components of a zone accepted by `/tokens/zone`.
+var zoneTokenScopes = []string{"cp", "ingress", "egress"}
+
+// zoneToken generates the tokens used by the zones to connect to the global control-plane, like `kumactl generate zone-token`.
+var zoneToken = tokenDefinition{
+	typeName:            "zone_token",
+	markdownDescription: "Generates a token used by the components of a zone to connect to the global control-plane, like `kumactl generate zone-token`.",
+	attributes: map[string]schema.Attribute{
+		"zone": schema.StringAttribute{
+			MarkdownDescription: "Name of the zone",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"scope": schema.SetAttribute{
+			MarkdownDescription: "Components of the zone allowed to use the token, some of `cp`, `ingress` and `egress`, defaults to all of them",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(stringvalidator.OneOf(zoneTokenScopes...)),
+			},
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.RequiresReplace(),
+			},
+		},
+	},
+	ephemeralAttributes: map[string]ephemeralschema.Attribute{
+		"zone": ephemeralschema.StringAttribute{
+			MarkdownDescription: "Name of the zone",
+			Required:            true,
+		},
+		"scope": ephemeralschema.SetAttribute{
+			MarkdownDescription: "Components of the zone allowed to use the token, some of `cp`, `ingress` and `egress`, defaults to all of them",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(stringvalidator.OneOf(zoneTokenScopes...)),
+			},
+		},
+	},
+	generate: func(ctx context.Context, client kumaapi.Client, data tokenRequestData) (string, diag.Diagnostics) {
+		var zone, validFor types.String
+		var scope types.Set
+		var diags diag.Diagnostics
+		diags.Append(data.GetAttribute(ctx, path.Root("zone"), &zone)...)
+		diags.Append(data.GetAttribute(ctx, path.Root("scope"), &scope)...)
+		diags.Append(data.GetAttribute(ctx, path.Root("valid_for"), &validFor)...)
+		if diags.HasError() {
+			return "", diags
+		}
+		return generateZoneToken(ctx, client, zone, scope, validFor)
+	},
+}
+
+func NewKumaZoneTokenResource() resource.Resource {
+	return newKumaTokenResource(zoneToken)()
+}
+
+func NewKumaZoneTokenEphemeralResource() ephemeral.EphemeralResource {
+	return newKumaTokenEphemeralResource(zoneToken)()
+}
+
+// generateZoneToken requests a zone token from the global control-plane.
+func generateZoneToken(ctx context.Context, client kumaapi.Client, zone types.String, scope types.Set, validFor types.String) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := kumaapi.ZoneTokenRequest{
+		Zone:     zone.ValueString(),
+		ValidFor: validFor.ValueString(),
+	}
+	if !scope.IsNull() {
+		diags.Append(scope.ElementsAs(ctx, &req.Scope, false)...)
+	}
+	if diags.HasError() {
+		return "", diags
+	}
+	token, err := client.GenerateZoneToken(ctx, req)
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Unable to generate zone token", err, tokenFieldPath)...)
+		return "", diags
+	}
+	return token, diags
+}
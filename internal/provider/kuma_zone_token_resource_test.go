@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGenerateZoneToken(t *testing.T) {
+	var got kumaapi.ZoneTokenRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		if got.ValidFor == "0s" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"title": "Could not issue a token", "causes": [{"field": "validFor", "message": "must be positive"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte("a.b.c"))
+	}))
+	defer srv.Close()
+	client := kumaapi.NewClient(srv.URL, "")
+	scope := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ingress")})
+
+	token, diags := generateZoneToken(context.Background(), client, types.StringValue("east"), scope, types.StringValue("720h"))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if token != "a.b.c" {
+		t.Errorf("expected token a.b.c got %q", token)
+	}
+	if diff := cmp.Diff(kumaapi.ZoneTokenRequest{Zone: "east", Scope: []string{"ingress"}, ValidFor: "720h"}, got); diff != "" {
+		t.Errorf("unexpected request (-want +got):\n%s", diff)
+	}
+
+	_, diags = generateZoneToken(context.Background(), client, types.StringValue("east"), types.SetNull(types.StringType), types.StringValue("0s"))
+	if len(diags) != 1 || diags[0].Summary() != "invalid resource" {
+		t.Fatalf("expected an invalid resource diagnostic got %v", diags)
+	}
+	if d, ok := diags[0].(interface{ Path() path.Path }); !ok || !d.Path().Equal(path.Root("valid_for")) {
+		t.Errorf("expected a diagnostic on valid_for got %v", diags[0])
+	}
+}
+
+func TestZoneTokenResourceCreate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("a.b.c"))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+	r := &KumaTokenResource{definition: zoneToken, client: kumaapi.NewClient(srv.URL, "")}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	plan := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"zone":         tftypes.NewValue(tftypes.String, "east"),
+		"scope":        tftypes.NewValue(typ.AttributeTypes["scope"], nil),
+		"valid_for":    tftypes.NewValue(tftypes.String, "720h"),
+		"renew_before": tftypes.NewValue(tftypes.String, "72h"),
+		"token":        tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"expires_at":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	req := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan}}
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)}}
+
+	r.Create(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	var zone, renewBefore, token, expiresAt types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("zone"), &zone)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("renew_before"), &renewBefore)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("token"), &token)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if zone.ValueString() != "east" || renewBefore.ValueString() != "72h" {
+		t.Errorf("expected the plan in the state got zone %s and renew_before %s", zone, renewBefore)
+	}
+	if token.ValueString() != "a.b.c" {
+		t.Errorf("expected token a.b.c got %s", token)
+	}
+	// The token isn't a JWT, its expiration can't be read.
+	if !expiresAt.IsNull() {
+		t.Errorf("expected a null expiration got %s", expiresAt)
+	}
+}
+
+func TestAccZoneTokenResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The local control-plane is standalone, only the validation of the configuration is tested.
+				Config: localProviderConfig + `
+resource "kuma_zone_token" "east" {
+  zone      = "east"
+  scope     = ["cp", "gateway"]
+  valid_for = "720h"
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
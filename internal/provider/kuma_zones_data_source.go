@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KumaZonesDataSource{}
+var _ datasource.DataSourceWithConfigure = &KumaZonesDataSource{}
+
+func NewKumaZonesDataSource() datasource.DataSource {
+	return &KumaZonesDataSource{}
+}
+
+// KumaZonesDataSource lists the zones and their insight.
+type KumaZonesDataSource struct {
+	client kumaapi.Client
+}
+
+// KumaZonesDataSourceModel describes the data source data model.
+type KumaZonesDataSourceModel struct {
+	NameContains types.String              `tfsdk:"name_contains"`
+	Labels       types.Map                 `tfsdk:"labels"`
+	PageSize     types.Int64               `tfsdk:"page_size"`
+	Items        []KumaZoneDataSourceModel `tfsdk:"items"`
+}
+
+func (d *KumaZonesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zones"
+}
+
+func (d *KumaZonesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	item := zoneDataSourceAttributes()
+	item["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the zone",
+		Computed:            true,
+	}
+	attributes := listFilterAttributes("zones")
+	attributes["items"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The zones, with the same attributes as the `kuma_zone` data source",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: item,
+		},
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the zones of a multi-zone deployment and their connection to the global control-plane, the provider must use the global control-plane.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *KumaZonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, _, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *KumaZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KumaZonesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters, diags := listFilters(ctx, data.NameContains, data.Labels, data.PageSize)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	zones, err := d.client.ListZoneOverviews(ctx, filters)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Unable to list zones", err, func(string) path.Path { return path.Empty() })...)
+		return
+	}
+	data.Items = make([]KumaZoneDataSourceModel, len(zones))
+	for i, zone := range zones {
+		data.Items[i].setZone(zone)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
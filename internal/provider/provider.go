@@ -5,19 +5,36 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
 	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/Kong/terraform-provider-kuma/internal/kumactl"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"net/http"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure KumaProvider satisfies various provider interfaces.
 var _ provider.Provider = &KumaProvider{}
+var _ provider.ProviderWithEphemeralResources = &KumaProvider{}
 
 // KumaProvider defines the provider implementation.
 type KumaProvider struct {
@@ -27,10 +44,57 @@ type KumaProvider struct {
 	version string
 }
 
+// kumaProviderData is shared by the provider with its resources and data sources.
+type kumaProviderData struct {
+	client   kumaapi.Client
+	metadata *kumaapi.MetadataCache
+}
+
 // KumaProviderModel describes the provider data model.
 type KumaProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	Token    types.String `tfsdk:"token"`
+	Endpoint             types.String        `tfsdk:"endpoint"`
+	Token                types.String        `tfsdk:"token"`
+	DryRun               types.Bool          `tfsdk:"dry_run"`
+	DryRunEndpoint       types.String        `tfsdk:"dry_run_endpoint"`
+	MaxRetries           types.Int64         `tfsdk:"max_retries"`
+	RetryMinBackoff      types.String        `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff      types.String        `tfsdk:"retry_max_backoff"`
+	RetryableStatusCodes types.List          `tfsdk:"retryable_status_codes"`
+	CACert               types.String        `tfsdk:"ca_cert"`
+	CACertFile           types.String        `tfsdk:"ca_cert_file"`
+	ClientCert           types.String        `tfsdk:"client_cert"`
+	ClientKey            types.String        `tfsdk:"client_key"`
+	TLSServerName        types.String        `tfsdk:"tls_server_name"`
+	InsecureSkipVerify   types.Bool          `tfsdk:"insecure_skip_verify"`
+	KumactlConfigPath    types.String        `tfsdk:"kumactl_config_path"`
+	KumactlContext       types.String        `tfsdk:"kumactl_context"`
+	Headers              types.Map           `tfsdk:"headers"`
+	TokenFile            types.String        `tfsdk:"token_file"`
+	BasicAuth            *KumaBasicAuthModel `tfsdk:"basic_auth"`
+	Exec                 *KumaExecModel      `tfsdk:"exec"`
+	Konnect              *KumaKonnectModel   `tfsdk:"konnect"`
+}
+
+// KumaKonnectModel describes the Konnect control-plane of the provider.
+type KumaKonnectModel struct {
+	Region           types.String `tfsdk:"region"`
+	ControlPlaneID   types.String `tfsdk:"control_plane_id"`
+	ControlPlaneName types.String `tfsdk:"control_plane_name"`
+	PAT              types.String `tfsdk:"pat"`
+	APIURL           types.String `tfsdk:"api_url"`
+}
+
+// KumaBasicAuthModel describes the basic authentication of the provider.
+type KumaBasicAuthModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+// KumaExecModel describes the credential plugin of the provider.
+type KumaExecModel struct {
+	Command types.String `tfsdk:"command"`
+	Args    types.List   `tfsdk:"args"`
+	Env     types.Map    `tfsdk:"env"`
 }
 
 func (p *KumaProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -43,15 +107,172 @@ func (p *KumaProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 		MarkdownDescription: "A provider used to use Terraform to manage Kuma service mesh entities",
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "Endpoint to the Global or Standalone Control-plane to use",
-				Optional:            false,
-				Required:            true,
+				MarkdownDescription: "Endpoint to the Global or Standalone Control-plane to use. Can be set with `KUMA_ENDPOINT` or taken from `kumactl_context`",
+				Optional:            true,
+				Required:            false,
 			},
 			"token": schema.StringAttribute{
 				MarkdownDescription: "Optional token if token is enabled",
 				Optional:            true,
 				Required:            false,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token_file"), path.MatchRoot("basic_auth"), path.MatchRoot("exec")),
+				},
+			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the token, the file is read again when it changes. Can be set with `KUMA_TOKEN_FILE`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("basic_auth"), path.MatchRoot("exec")),
+				},
+			},
+			"basic_auth": schema.SingleNestedAttribute{
+				MarkdownDescription: "Authenticate with a username and a password, e.g. with a control-plane behind a reverse proxy",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Required: true,
+					},
+					"password": schema.StringAttribute{
+						Required:  true,
+						Sensitive: true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("exec")),
+				},
+			},
+			"exec": schema.SingleNestedAttribute{
+				MarkdownDescription: "Credential plugin authenticating with the token it prints on stdout, either `{\"token\": \"...\", \"expirationTimestamp\": \"<RFC 3339>\"}` " +
+					"or a kubernetes `ExecCredential`. The command runs again shortly before the token expires",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"command": schema.StringAttribute{
+						MarkdownDescription: "Command to run",
+						Required:            true,
+					},
+					"args": schema.ListAttribute{
+						MarkdownDescription: "Arguments of the command",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"env": schema.MapAttribute{
+						MarkdownDescription: "Environment variables added to the environment of the provider",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Headers added to every request to the control-plane",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"dry_run": schema.BoolAttribute{
+				MarkdownDescription: "Submit resources to `dry_run_endpoint` during plan so that their validation errors are reported before apply. " +
+					"The Kuma api has no dry-run mode, it requires a validation endpoint, e.g. a proxy or an admission service in front of the control-plane",
+				Optional: true,
+			},
+			"dry_run_endpoint": schema.StringAttribute{
+				MarkdownDescription: "Path validating a resource sent with `PUT` without writing it, required by `dry_run`. " +
+					"`{path}`, `{mesh}`, `{type}` and `{name}` are replaced by the api path, the mesh, the api type and the name of the resource. " +
+					"The api path of the resource itself is rejected as it would write the resource during plan",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of retries of a request failing with a connection error or a retryable status code, defaults to `%d`. `0` disables retries", kumaapi.DefaultRetryPolicy.MaxRetries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_backoff": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Wait before the first retry as a duration (e.g. `500ms`), it doubles on every retry. Defaults to `%s`. A `Retry-After` response header takes precedence", kumaapi.DefaultRetryPolicy.MinBackoff),
+				Optional:            true,
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Maximum wait between retries as a duration (e.g. `1m`), `Retry-After` response headers included. Defaults to `%s`", kumaapi.DefaultRetryPolicy.MaxBackoff),
+				Optional:            true,
+			},
+			"retryable_status_codes": schema.ListAttribute{
+				MarkdownDescription: fmt.Sprintf("Response status codes to retry, defaults to `[%s]`", strings.Join(strings.Fields(strings.Trim(fmt.Sprint(kumaapi.DefaultRetryPolicy.RetryableStatusCodes), "[]")), ", ")),
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"ca_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate used to verify the certificate of the control-plane instead of the system pool. Can be set with `KUMA_CA_CERT`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA certificate used to verify the certificate of the control-plane instead of the system pool. Can be set with `KUMA_CA_CERT_FILE`",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate or path to it, used when the control-plane requires mTLS. Can be set with `KUMA_CLIENT_CERT`",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded key of `client_cert` or path to it. Can be set with `KUMA_CLIENT_KEY`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"tls_server_name": schema.StringAttribute{
+				MarkdownDescription: "Name used to verify the certificate of the control-plane when it differs from the host of `endpoint`. Can be set with `KUMA_TLS_SERVER_NAME`",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Don't verify the certificate of the control-plane, only use it for testing. Can be set with `KUMA_INSECURE_SKIP_VERIFY`",
+				Optional:            true,
+			},
+			"kumactl_config_path": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Path to a kumactl configuration, defaults to `~/%s`. Setting it uses `kumactl_context`", kumactl.DefaultConfigPath),
+				Optional:            true,
+			},
+			"kumactl_context": schema.StringAttribute{
+				MarkdownDescription: "kumactl context providing the endpoint, token, headers and tls settings which aren't set in the provider, " +
+					"the current context is used when only `kumactl_config_path` is set. Can be set with `KUMA_CONTEXT`",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"konnect": schema.SingleNestedBlock{
+				MarkdownDescription: "Use a mesh control-plane of Konnect, the endpoint and token are derived from it so it conflicts with the other endpoint and credential settings",
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Konnect region of the control-plane, defaults to `us`, one of `%s`", strings.Join(kumaapi.KonnectRegions, "`, `")),
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(kumaapi.KonnectRegions...),
+						},
+					},
+					"control_plane_id": schema.StringAttribute{
+						MarkdownDescription: "Id of the mesh control-plane",
+						Optional:            true,
+					},
+					"control_plane_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the mesh control-plane, it's resolved to its id",
+						Optional:            true,
+					},
+					"pat": schema.StringAttribute{
+						MarkdownDescription: "Konnect personal or system access token. Can be set with `KONNECT_PAT`",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"api_url": schema.StringAttribute{
+						MarkdownDescription: "Url of the Konnect api, defaults to the one of `region`",
+						Optional:            true,
+					},
+				},
+				Validators: []validator.Object{
+					// The token of the control-plane is the Konnect token, other credentials would replace it.
+					objectvalidator.ConflictsWith(path.MatchRoot("endpoint"), path.MatchRoot("kumactl_context"), path.MatchRoot("kumactl_config_path"),
+						path.MatchRoot("token"), path.MatchRoot("token_file"), path.MatchRoot("basic_auth"), path.MatchRoot("exec")),
+				},
 			},
 		},
 	}
@@ -78,6 +299,9 @@ func (p *KumaProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 				"Either target apply the source of the value first, set the value statically in the configuration, or use the KUMA_TOKEN environment variable.",
 		)
 	}
+	if data.Konnect != nil {
+		resp.Diagnostics.Append(data.Konnect.checkUnknown()...)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -93,24 +317,377 @@ func (p *KumaProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		token = data.Token.ValueString()
 	}
 
-	// Example client configuration for data sources and resources
-	client := kumaapi.NewClient(endpoint, token)
+	var opts []kumaapi.ClientOption
+	var tlsOptions kumaapi.TLSOptions
+	apiServer, diags := data.kumactlApiServer()
+	resp.Diagnostics.Append(diags...)
+	if apiServer != nil {
+		if endpoint == "" {
+			endpoint = apiServer.URL
+		}
+		if token == "" {
+			t, err := apiServer.Token()
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("kumactl_context"), "invalid kumactl context", err.Error())
+			}
+			token = t
+		}
+		headers := http.Header{}
+		for _, h := range apiServer.Headers {
+			headers.Add(h.Key, h.Value)
+		}
+		opts = append(opts, kumaapi.WithHeaders(headers))
+		tlsOptions.InsecureSkipVerify = apiServer.SkipVerify
+		resp.Diagnostics.Append(readPEM(apiServer.CACertFile, path.Root("kumactl_context"), &tlsOptions.CACert)...)
+		resp.Diagnostics.Append(readPEM(apiServer.ClientCertFile, path.Root("kumactl_context"), &tlsOptions.ClientCert)...)
+		resp.Diagnostics.Append(readPEM(apiServer.ClientKeyFile, path.Root("kumactl_context"), &tlsOptions.ClientKey)...)
+	}
+	if !data.Headers.IsNull() {
+		headers := map[string]string{}
+		resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &headers, false)...)
+		h := http.Header{}
+		for k, v := range headers {
+			h.Set(k, v)
+		}
+		opts = append(opts, kumaapi.WithHeaders(h))
+	}
+	auth, diags := data.authenticator(ctx)
+	resp.Diagnostics.Append(diags...)
+	if auth != nil {
+		opts = append(opts, kumaapi.WithAuthenticator(auth))
+	}
+	resp.Diagnostics.Append(data.checkDryRun()...)
+	if data.DryRun.ValueBool() {
+		opts = append(opts, kumaapi.WithDryRun(data.DryRunEndpoint.ValueString()))
+	}
+	retry, diags := data.retryPolicy(ctx)
+	resp.Diagnostics.Append(diags...)
+	opts = append(opts, kumaapi.WithRetryPolicy(retry))
+	tlsConfig, diags := data.tlsConfig(tlsOptions)
+	resp.Diagnostics.Append(diags...)
+	if tlsConfig != nil {
+		opts = append(opts, kumaapi.WithTLS(tlsConfig))
+	}
+	if data.Konnect != nil && !resp.Diagnostics.HasError() {
+		endpoint, token, diags = data.Konnect.resolve(ctx, kumaapi.WithRetryPolicy(retry))
+		resp.Diagnostics.Append(diags...)
+	}
+	if endpoint == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Missing Kuma cp endpoint",
+			"The provider cannot create the Kuma API client as there is no endpoint. "+
+				"Set the value in the configuration, use the KUMA_ENDPOINT environment variable a kumactl context or a konnect block.",
+		)
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	client := kumaapi.NewClient(endpoint, token, opts...)
+
+	// The control-plane is only discovered once, resources and data sources share its metadata.
+	providerData := &kumaProviderData{client: client, metadata: kumaapi.NewMetadataCache(client)}
+	metadata, err := providerData.metadata.Get(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to connect to the Kuma control-plane", heartbeatErrorDetail(endpoint, metadata, err))
+		return
+	}
+	resp.Diagnostics.Append(checkVersion(metadata)...)
+	tflog.Info(ctx, "successfully checked connection", map[string]interface{}{
+		"product":   metadata.Product,
+		"version":   metadata.Version,
+		"resources": len(metadata.Resources),
+	})
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
+}
+
+// heartbeatErrorDetail describes a failed discovery of the control-plane, with its product and version when they
+// were retrieved before the error.
+func heartbeatErrorDetail(endpoint string, metadata kumaapi.Metadata, err error) string {
+	cp := "the control-plane"
+	if metadata.Product != "" {
+		cp = fmt.Sprintf("%s %s", metadata.Product, metadata.Version)
+	}
+	return fmt.Sprintf("The provider failed to discover the resources of %s at %s, check the endpoint and credentials, got error: %s", cp, endpoint, err)
+}
+
+// checkDryRun reports a dry-run without a validation endpoint, the control-plane would write the resources during plan.
+func (m KumaProviderModel) checkDryRun() diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !m.DryRun.ValueBool() {
+		return diags
+	}
+	if err := kumaapi.CheckDryRunEndpoint(m.DryRunEndpoint.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("dry_run_endpoint"), "invalid dry-run endpoint",
+			fmt.Sprintf("`dry_run` requires a path validating resources without writing them, got error: %s", err))
+	}
+	return diags
+}
+
+// retryPolicy returns kumaapi.DefaultRetryPolicy with the configured overrides.
+func (m KumaProviderModel) retryPolicy(ctx context.Context) (kumaapi.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policy := kumaapi.DefaultRetryPolicy
+	// Unknown values keep the defaults like null ones, they can't be resolved at configure time.
+	if !m.MaxRetries.IsNull() && !m.MaxRetries.IsUnknown() {
+		policy.MaxRetries = int(m.MaxRetries.ValueInt64())
+	}
+	diags.Append(parseDuration(m.RetryMinBackoff, path.Root("retry_min_backoff"), &policy.MinBackoff)...)
+	diags.Append(parseDuration(m.RetryMaxBackoff, path.Root("retry_max_backoff"), &policy.MaxBackoff)...)
+	if policy.MinBackoff > policy.MaxBackoff {
+		diags.AddAttributeError(path.Root("retry_min_backoff"), "invalid duration", fmt.Sprintf("retry_min_backoff `%s` is greater than retry_max_backoff `%s`", policy.MinBackoff, policy.MaxBackoff))
+	}
+	if !m.RetryableStatusCodes.IsNull() && !m.RetryableStatusCodes.IsUnknown() {
+		var codes []int64
+		diags.Append(m.RetryableStatusCodes.ElementsAs(ctx, &codes, false)...)
+		policy.RetryableStatusCodes = make([]int, 0, len(codes))
+		for _, c := range codes {
+			policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, int(c))
+		}
+	}
+	return policy, diags
+}
+
+// tlsConfig returns opts overridden by the tls settings set in the configuration or with the KUMA_* environment
+// variables, it's nil when none is set.
+func (m KumaProviderModel) tlsConfig(opts kumaapi.TLSOptions) (*tls.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if v := stringOrEnv(m.TLSServerName, "KUMA_TLS_SERVER_NAME"); v != "" {
+		opts.ServerName = v
+	}
+	if !m.InsecureSkipVerify.IsNull() {
+		opts.InsecureSkipVerify = m.InsecureSkipVerify.ValueBool()
+	} else if v := os.Getenv("KUMA_INSECURE_SKIP_VERIFY"); v != "" {
+		insecure, err := strconv.ParseBool(v)
+		if err != nil {
+			diags.AddAttributeError(path.Root("insecure_skip_verify"), "invalid tls configuration", fmt.Sprintf("KUMA_INSECURE_SKIP_VERIFY `%s` is not a boolean", v))
+		}
+		opts.InsecureSkipVerify = insecure
+	}
+	diags.Append(readPEM(stringOrEnv(m.CACert, "KUMA_CA_CERT"), path.Root("ca_cert"), &opts.CACert)...)
+	diags.Append(readPEM(stringOrEnv(m.CACertFile, "KUMA_CA_CERT_FILE"), path.Root("ca_cert_file"), &opts.CACert)...)
+	diags.Append(readPEM(stringOrEnv(m.ClientCert, "KUMA_CLIENT_CERT"), path.Root("client_cert"), &opts.ClientCert)...)
+	diags.Append(readPEM(stringOrEnv(m.ClientKey, "KUMA_CLIENT_KEY"), path.Root("client_key"), &opts.ClientKey)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if opts.ServerName == "" && !opts.InsecureSkipVerify && opts.CACert == nil && opts.ClientCert == nil && opts.ClientKey == nil {
+		return nil, diags
+	}
+	cfg, err := opts.Config()
+	if err != nil {
+		diags.AddError("invalid tls configuration", err.Error())
+	}
+	return cfg, diags
+}
+
+// checkUnknown reports the attributes which aren't known yet, the control-plane can't be resolved without them.
+func (m KumaKonnectModel) checkUnknown() diag.Diagnostics {
+	var diags diag.Diagnostics
+	attributes := map[string]types.String{
+		"region":             m.Region,
+		"control_plane_id":   m.ControlPlaneID,
+		"control_plane_name": m.ControlPlaneName,
+		"pat":                m.PAT,
+		"api_url":            m.APIURL,
+	}
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if attributes[name].IsUnknown() {
+			diags.AddAttributeError(
+				path.Root("konnect").AtName(name),
+				"Unknown Konnect configuration",
+				fmt.Sprintf("The provider cannot create the Kuma API client as there is an unknown configuration value for `konnect.%s`. ", name)+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+		}
+	}
+	return diags
+}
+
+// resolve returns the endpoint of the Konnect control-plane and the token to use it.
+func (m KumaKonnectModel) resolve(ctx context.Context, opts ...kumaapi.ClientOption) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	konnectPath := path.Root("konnect")
+	pat := stringOrEnv(m.PAT, "KONNECT_PAT")
+	if pat == "" {
+		diags.AddAttributeError(konnectPath.AtName("pat"), "Missing Konnect token", "Set `pat` or use the KONNECT_PAT environment variable.")
+	}
+	if m.ControlPlaneID.IsNull() == m.ControlPlaneName.IsNull() {
+		diags.AddAttributeError(konnectPath, "invalid konnect configuration", "Exactly one of `control_plane_id` and `control_plane_name` must be set.")
+	}
+	if diags.HasError() {
+		return "", "", diags
+	}
+	apiURL := m.APIURL.ValueString()
+	if apiURL == "" {
+		region := m.Region.ValueString()
+		if region == "" {
+			region = "us"
+		}
+		apiURL = kumaapi.KonnectAPIURL(region)
+	}
+	konnect := kumaapi.NewKonnectClient(apiURL, pat, opts...)
+	var cp kumaapi.KonnectControlPlane
+	var err error
+	if m.ControlPlaneName.IsNull() {
+		cp, err = konnect.ControlPlane(ctx, m.ControlPlaneID.ValueString())
+	} else {
+		cp, err = konnect.ControlPlaneByName(ctx, m.ControlPlaneName.ValueString())
+	}
+	if err != nil {
+		diags.Append(konnectDiagnostics(err, m.ControlPlaneID.ValueString()+m.ControlPlaneName.ValueString())...)
+		return "", "", diags
+	}
+	return konnect.Endpoint(cp.ID), pat, diags
+}
+
+// konnectDiagnostics explains why the control-plane cp couldn't be retrieved from Konnect.
+func konnectDiagnostics(err error, cp string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var apiErr *kumaapi.APIError
+	konnectPath := path.Root("konnect")
+	switch {
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized:
+		diags.AddAttributeError(konnectPath.AtName("pat"), "invalid Konnect token",
+			fmt.Sprintf("Konnect rejected the token, check that it's valid and not expired, got error: %s", err))
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden:
+		diags.AddAttributeError(konnectPath.AtName("pat"), "insufficient Konnect permissions",
+			fmt.Sprintf("The token doesn't have access to the mesh control-plane `%s`, give its user or system account a role on it (e.g. `Admin` to manage resources), got error: %s", cp, err))
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+		diags.AddAttributeError(konnectPath.AtName("control_plane_id"), "unknown Konnect control-plane",
+			fmt.Sprintf("The mesh control-plane `%s` doesn't exist in this region, got error: %s", cp, err))
+	default:
+		diags.AddAttributeError(konnectPath, "client Error", fmt.Sprintf("Unable to retrieve the Konnect control-plane `%s`, got error: %s", cp, err))
+	}
+	return diags
+}
+
+// authenticator returns the authentication configured in the provider, it's nil when using a bearer token.
+func (m KumaProviderModel) authenticator(ctx context.Context) (kumaapi.Authenticator, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch {
+	case m.BasicAuth != nil:
+		return kumaapi.BasicAuth(m.BasicAuth.Username.ValueString(), m.BasicAuth.Password.ValueString()), diags
+	case m.Exec != nil:
+		cmd := kumaapi.ExecCommand{Command: m.Exec.Command.ValueString()}
+		if !m.Exec.Args.IsNull() {
+			diags.Append(m.Exec.Args.ElementsAs(ctx, &cmd.Args, false)...)
+		}
+		if !m.Exec.Env.IsNull() {
+			diags.Append(m.Exec.Env.ElementsAs(ctx, &cmd.Env, false)...)
+		}
+		return kumaapi.Exec(cmd), diags
+	case !m.TokenFile.IsNull():
+		return kumaapi.TokenFile(m.TokenFile.ValueString()), diags
+	// The Konnect token is used instead of the environment.
+	case m.Token.IsNull() && m.Konnect == nil && os.Getenv("KUMA_TOKEN_FILE") != "":
+		return kumaapi.TokenFile(os.Getenv("KUMA_TOKEN_FILE")), diags
+	}
+	return nil, diags
+}
+
+// kumactlApiServer returns the control-plane of the kumactl context, it's nil unless `kumactl_config_path`,
+// `kumactl_context` or `KUMA_CONTEXT` is set.
+func (m KumaProviderModel) kumactlApiServer() (*kumactl.ApiServer, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	configPath := m.KumactlConfigPath.ValueString()
+	contextName := stringOrEnv(m.KumactlContext, "KUMA_CONTEXT")
+	if configPath == "" && contextName == "" {
+		return nil, diags
+	}
+	cfg, err := kumactl.Load(configPath)
+	if err != nil {
+		diags.AddAttributeError(path.Root("kumactl_config_path"), "invalid kumactl config", err.Error())
+		return nil, diags
+	}
+	apiServer, err := cfg.ApiServer(contextName)
+	if err != nil {
+		diags.AddAttributeError(path.Root("kumactl_context"), "invalid kumactl context", err.Error())
+		return nil, diags
+	}
+	return &apiServer, diags
+}
+
+// stringOrEnv returns the value of v or of the environment variable env when v is null.
+func stringOrEnv(v types.String, env string) string {
+	if v.IsNull() {
+		return os.Getenv(env)
+	}
+	return v.ValueString()
+}
+
+// readPEM sets out to v when it's PEM encoded or to the content of the file at path v, empty values are ignored.
+func readPEM(v string, attribute path.Path, out *[]byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if v == "" {
+		return diags
+	}
+	if strings.Contains(v, "-----BEGIN") {
+		*out = []byte(v)
+		return diags
+	}
+	b, err := os.ReadFile(v)
+	if err != nil {
+		diags.AddAttributeError(attribute, "invalid tls configuration", fmt.Sprintf("Failed to read `%s`, got error: %s", v, err))
+		return diags
+	}
+	*out = b
+	return diags
+}
+
+// parseDuration sets out to the duration in v unless it's null or unknown.
+func parseDuration(v types.String, attribute path.Path, out *time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if v.IsNull() || v.IsUnknown() {
+		return diags
+	}
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil || d < 0 {
+		diags.AddAttributeError(attribute, "invalid duration", fmt.Sprintf("`%s` is not a valid duration, use a value like `500ms` or `30s`", v.ValueString()))
+		return diags
+	}
+	*out = d
+	return diags
 }
 
 func (p *KumaProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	resources := []func() resource.Resource{
 		NewKumaMeshedResource,
+		NewKumaMeshResource,
+		NewKumaDataplaneTokenResource,
+		NewKumaZoneTokenResource,
+	}
+	return append(resources, policyResources...)
+}
+
+func (p *KumaProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewKumaDataplaneTokenEphemeralResource,
+		NewKumaZoneTokenEphemeralResource,
 	}
 }
 
 func (p *KumaProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewKumaResourceDataSource,
+		NewKumaResourcesDataSource,
+		NewKumaMeshDataSource,
+		NewKumaMeshesDataSource,
+		NewKumaZoneDataSource,
+		NewKumaZonesDataSource,
+		NewKumaDataplaneDataSource,
+		NewKumaDataplanesDataSource,
+		NewKumaPoliciesForDataplaneDataSource,
+		NewKumaPolicyAffectedDataplanesDataSource,
+	}
 }
 
 func New(version string) func() provider.Provider {
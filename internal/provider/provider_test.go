@@ -4,10 +4,23 @@
 package provider
 
 import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 const localProviderConfig = `
@@ -29,3 +42,421 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+func TestProviderSchema(t *testing.T) {
+	server := providerserver.NewProtocol6(New("test")())()
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+}
+
+func TestProviderKonnectConflicts(t *testing.T) {
+	server := providerserver.NewProtocol6(New("test")())()
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	configType := schemaResp.Provider.ValueType().(tftypes.Object)
+	// withValues returns an object of typ with the values and null attributes otherwise.
+	withValues := func(typ tftypes.Object, values map[string]tftypes.Value) tftypes.Value {
+		attributes := map[string]tftypes.Value{}
+		for name, attributeType := range typ.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+		for name, v := range values {
+			attributes[name] = v
+		}
+		return tftypes.NewValue(typ, attributes)
+	}
+	konnect := withValues(configType.AttributeTypes["konnect"].(tftypes.Object), map[string]tftypes.Value{
+		"control_plane_id": tftypes.NewValue(tftypes.String, "9f2a"),
+	})
+	tests := map[string]map[string]tftypes.Value{
+		"konnect only": {},
+		"token":        {"token": tftypes.NewValue(tftypes.String, "token")},
+		"token_file":   {"token_file": tftypes.NewValue(tftypes.String, "/token")},
+		"basic_auth": {"basic_auth": withValues(configType.AttributeTypes["basic_auth"].(tftypes.Object), map[string]tftypes.Value{
+			"username": tftypes.NewValue(tftypes.String, "admin"),
+			"password": tftypes.NewValue(tftypes.String, "secret"),
+		})},
+		"exec": {"exec": withValues(configType.AttributeTypes["exec"].(tftypes.Object), map[string]tftypes.Value{
+			"command": tftypes.NewValue(tftypes.String, "get-token"),
+		})},
+	}
+	for name, values := range tests {
+		t.Run(name, func(t *testing.T) {
+			values["konnect"] = konnect
+			config, err := tfprotov6.NewDynamicValue(configType, withValues(configType, values))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := server.ValidateProviderConfig(context.Background(), &tfprotov6.ValidateProviderConfigRequest{Config: &config})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			conflicts := false
+			for _, d := range resp.Diagnostics {
+				conflicts = conflicts || d.Summary == "Invalid Attribute Combination"
+			}
+			if wantConflict := name != "konnect only"; conflicts != wantConflict {
+				t.Errorf("expected a conflict %t got %v", wantConflict, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestProviderRetryPolicy(t *testing.T) {
+	codes, _ := types.ListValueFrom(context.Background(), types.Int64Type, []int64{503})
+	tests := map[string]struct {
+		model     KumaProviderModel
+		want      kumaapi.RetryPolicy
+		wantError bool
+	}{
+		"defaults": {
+			model: KumaProviderModel{
+				MaxRetries:           types.Int64Null(),
+				RetryMinBackoff:      types.StringNull(),
+				RetryMaxBackoff:      types.StringNull(),
+				RetryableStatusCodes: types.ListNull(types.Int64Type),
+			},
+			want: kumaapi.DefaultRetryPolicy,
+		},
+		"overrides": {
+			model: KumaProviderModel{
+				MaxRetries:           types.Int64Value(5),
+				RetryMinBackoff:      types.StringValue("100ms"),
+				RetryMaxBackoff:      types.StringValue("2s"),
+				RetryableStatusCodes: codes,
+			},
+			want: kumaapi.RetryPolicy{MaxRetries: 5, MinBackoff: 100 * time.Millisecond, MaxBackoff: 2 * time.Second, RetryableStatusCodes: []int{503}},
+		},
+		"unknown": {
+			model: KumaProviderModel{
+				MaxRetries:           types.Int64Unknown(),
+				RetryMinBackoff:      types.StringUnknown(),
+				RetryMaxBackoff:      types.StringUnknown(),
+				RetryableStatusCodes: types.ListUnknown(types.Int64Type),
+			},
+			want: kumaapi.DefaultRetryPolicy,
+		},
+		"invalid duration": {
+			model: KumaProviderModel{
+				MaxRetries:           types.Int64Null(),
+				RetryMinBackoff:      types.StringValue("soon"),
+				RetryMaxBackoff:      types.StringNull(),
+				RetryableStatusCodes: types.ListNull(types.Int64Type),
+			},
+			wantError: true,
+		},
+		"min greater than max": {
+			model: KumaProviderModel{
+				MaxRetries:           types.Int64Null(),
+				RetryMinBackoff:      types.StringValue("1m"),
+				RetryMaxBackoff:      types.StringValue("10s"),
+				RetryableStatusCodes: types.ListNull(types.Int64Type),
+			},
+			wantError: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := tt.model.retryPolicy(context.Background())
+			if diags.HasError() != tt.wantError {
+				t.Fatalf("unexpected diagnostics %v", diags)
+			}
+			if tt.wantError {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderCheckDryRun(t *testing.T) {
+	tests := map[string]struct {
+		model     KumaProviderModel
+		wantError bool
+	}{
+		"disabled": {
+			model: KumaProviderModel{DryRun: types.BoolNull(), DryRunEndpoint: types.StringNull()},
+		},
+		"validation endpoint": {
+			model: KumaProviderModel{DryRun: types.BoolValue(true), DryRunEndpoint: types.StringValue("/validate/{mesh}/{type}/{name}")},
+		},
+		"missing endpoint": {
+			model:     KumaProviderModel{DryRun: types.BoolValue(true), DryRunEndpoint: types.StringNull()},
+			wantError: true,
+		},
+		"resource path": {
+			model:     KumaProviderModel{DryRun: types.BoolValue(true), DryRunEndpoint: types.StringValue("{path}?dryRun=true")},
+			wantError: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diags := tt.model.checkDryRun(); diags.HasError() != tt.wantError {
+				t.Errorf("unexpected diagnostics %v", diags)
+			}
+		})
+	}
+}
+
+func TestProviderTLSConfig(t *testing.T) {
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	defer srv.Close()
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caCertFile, []byte(caCert), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, diags := KumaProviderModel{}.tlsConfig(kumaapi.TLSOptions{})
+	if diags.HasError() || cfg != nil {
+		t.Errorf("expected no tls configuration got %v %v", cfg, diags)
+	}
+
+	for name, model := range map[string]KumaProviderModel{
+		"ca_cert":      {CACert: types.StringValue(caCert), TLSServerName: types.StringValue("example.com")},
+		"ca_cert_file": {CACertFile: types.StringValue(caCertFile), TLSServerName: types.StringValue("example.com")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg, diags := model.tlsConfig(kumaapi.TLSOptions{})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics %v", diags)
+			}
+			if cfg.RootCAs == nil || cfg.ServerName != "example.com" {
+				t.Errorf("unexpected tls configuration %+v", cfg)
+			}
+		})
+	}
+
+	t.Run("environment", func(t *testing.T) {
+		t.Setenv("KUMA_INSECURE_SKIP_VERIFY", "true")
+		t.Setenv("KUMA_CA_CERT_FILE", caCertFile)
+		cfg, diags := KumaProviderModel{}.tlsConfig(kumaapi.TLSOptions{})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics %v", diags)
+		}
+		if !cfg.InsecureSkipVerify || cfg.RootCAs == nil {
+			t.Errorf("unexpected tls configuration %+v", cfg)
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, diags := KumaProviderModel{ClientCert: types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))}.tlsConfig(kumaapi.TLSOptions{})
+		if !diags.HasError() {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestProviderKumactlApiServer(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config")
+	err := os.WriteFile(configPath, []byte(`
+contexts:
+- controlPlane: local
+  name: local
+- controlPlane: prod
+  name: prod
+controlPlanes:
+- coordinates:
+    apiServer:
+      url: http://localhost:5681
+  name: local
+- coordinates:
+    apiServer:
+      url: https://kuma.example.com:5682
+  name: prod
+currentContext: local
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiServer, diags := KumaProviderModel{}.kumactlApiServer()
+	if diags.HasError() || apiServer != nil {
+		t.Errorf("expected kumactl to be unused got %v %v", apiServer, diags)
+	}
+
+	apiServer, diags = KumaProviderModel{KumactlConfigPath: types.StringValue(configPath)}.kumactlApiServer()
+	if diags.HasError() || apiServer.URL != "http://localhost:5681" {
+		t.Errorf("expected the current context got %v %v", apiServer, diags)
+	}
+
+	t.Setenv("KUMA_CONTEXT", "prod")
+	apiServer, diags = KumaProviderModel{KumactlConfigPath: types.StringValue(configPath)}.kumactlApiServer()
+	if diags.HasError() || apiServer.URL != "https://kuma.example.com:5682" {
+		t.Errorf("expected the KUMA_CONTEXT context got %v %v", apiServer, diags)
+	}
+
+	_, diags = KumaProviderModel{KumactlConfigPath: types.StringValue(configPath), KumactlContext: types.StringValue("staging")}.kumactlApiServer()
+	if !diags.HasError() {
+		t.Error("expected an unknown context to fail")
+	}
+}
+
+func TestProviderAuthenticator(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("from-file"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	args, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"-c", `echo "{\"token\": \"$TOKEN\"}"`})
+	env, _ := types.MapValueFrom(context.Background(), types.StringType, map[string]string{"TOKEN": "from-exec"})
+	tests := map[string]struct {
+		model KumaProviderModel
+		env   string
+		want  string
+	}{
+		"bearer token": {
+			model: KumaProviderModel{Token: types.StringValue("token")},
+		},
+		"basic auth": {
+			model: KumaProviderModel{BasicAuth: &KumaBasicAuthModel{Username: types.StringValue("admin"), Password: types.StringValue("secret")}},
+			want:  "Basic YWRtaW46c2VjcmV0",
+		},
+		"token file": {
+			model: KumaProviderModel{TokenFile: types.StringValue(tokenFile)},
+			want:  "Bearer from-file",
+		},
+		"token file from the environment": {
+			env:  tokenFile,
+			want: "Bearer from-file",
+		},
+		"token takes precedence over the environment": {
+			model: KumaProviderModel{Token: types.StringValue("token")},
+			env:   tokenFile,
+		},
+		"konnect takes precedence over the environment": {
+			model: KumaProviderModel{Konnect: &KumaKonnectModel{ControlPlaneID: types.StringValue("9f2a")}},
+			env:   tokenFile,
+		},
+		"exec": {
+			model: KumaProviderModel{Exec: &KumaExecModel{Command: types.StringValue("sh"), Args: args, Env: env}},
+			want:  "Bearer from-exec",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("KUMA_TOKEN_FILE", tt.env)
+			auth, diags := tt.model.authenticator(context.Background())
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics %v", diags)
+			}
+			if tt.want == "" {
+				if auth != nil {
+					t.Errorf("expected the bearer token of the client got %T", auth)
+				}
+				return
+			}
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if err := auth.Authenticate(context.Background(), req); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := req.Header.Get("Authorization"); got != tt.want {
+				t.Errorf("expected %q got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestKonnectResolve(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Header.Get("Authorization") != "Bearer kpat_valid":
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "Unauthorized"}`))
+		case r.URL.Path == "/v1/mesh/control-planes":
+			_, _ = w.Write([]byte(`{"data": [{"id": "9f2a", "name": "prod"}], "meta": {"page": {"number": 1, "size": 100, "total": 1}}}`))
+		case r.URL.Path == "/v1/mesh/control-planes/9f2a":
+			_, _ = w.Write([]byte(`{"id": "9f2a", "name": "prod"}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"status": 403, "title": "Forbidden", "detail": "You do not have permission to perform this action"}`))
+		}
+	}))
+	defer srv.Close()
+
+	tests := map[string]struct {
+		model       KumaKonnectModel
+		wantSummary string
+	}{
+		"by id": {
+			model: KumaKonnectModel{ControlPlaneID: types.StringValue("9f2a"), PAT: types.StringValue("kpat_valid")},
+		},
+		"by name": {
+			model: KumaKonnectModel{ControlPlaneName: types.StringValue("prod"), PAT: types.StringValue("kpat_valid")},
+		},
+		"invalid token": {
+			model:       KumaKonnectModel{ControlPlaneID: types.StringValue("9f2a"), PAT: types.StringValue("kpat_expired")},
+			wantSummary: "invalid Konnect token",
+		},
+		"missing permission": {
+			model:       KumaKonnectModel{ControlPlaneID: types.StringValue("other"), PAT: types.StringValue("kpat_valid")},
+			wantSummary: "insufficient Konnect permissions",
+		},
+		"missing control-plane": {
+			model:       KumaKonnectModel{PAT: types.StringValue("kpat_valid")},
+			wantSummary: "invalid konnect configuration",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt.model.APIURL = types.StringValue(srv.URL)
+			endpoint, token, diags := tt.model.resolve(context.Background(), kumaapi.WithRetryPolicy(kumaapi.RetryPolicy{}))
+			if tt.wantSummary != "" {
+				if len(diags) != 1 || diags[0].Summary() != tt.wantSummary {
+					t.Errorf("expected %q got %v", tt.wantSummary, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics %v", diags)
+			}
+			if endpoint != srv.URL+"/v1/mesh/control-planes/9f2a/api" || token != "kpat_valid" {
+				t.Errorf("unexpected endpoint %s and token %s", endpoint, token)
+			}
+		})
+	}
+}
+
+func TestKonnectCheckUnknown(t *testing.T) {
+	model := KumaKonnectModel{
+		Region:           types.StringNull(),
+		ControlPlaneID:   types.StringUnknown(),
+		ControlPlaneName: types.StringNull(),
+		PAT:              types.StringUnknown(),
+		APIURL:           types.StringValue("https://eu.api.konghq.com"),
+	}
+	diags := model.checkUnknown()
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics got %v", diags)
+	}
+	for i, name := range []string{"control_plane_id", "pat"} {
+		d, ok := diags[i].(interface{ Path() path.Path })
+		if !ok || !d.Path().Equal(path.Root("konnect").AtName(name)) {
+			t.Errorf("expected a diagnostic on konnect.%s got %v", name, diags[i])
+		}
+	}
+	model.ControlPlaneID = types.StringValue("9f2a")
+	model.PAT = types.StringValue("kpat_valid")
+	if diags := model.checkUnknown(); diags.HasError() {
+		t.Errorf("unexpected diagnostics %v", diags)
+	}
+}
+
+func TestHeartbeatErrorDetail(t *testing.T) {
+	err := errors.New("connection refused")
+	if got, want := heartbeatErrorDetail("http://localhost:5681", kumaapi.Metadata{}, err), "The provider failed to discover the resources of the control-plane at http://localhost:5681, check the endpoint and credentials, got error: connection refused"; got != want {
+		t.Errorf("expected %q got %q", want, got)
+	}
+	metadata := kumaapi.Metadata{Product: "Kong Mesh", Version: "2.7.1"}
+	if got, want := heartbeatErrorDetail("http://localhost:5681", metadata, err), "The provider failed to discover the resources of Kong Mesh 2.7.1 at http://localhost:5681, check the endpoint and credentials, got error: connection refused"; got != want {
+		t.Errorf("expected %q got %q", want, got)
+	}
+}
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &KumaTokenResource{}
+var _ resource.ResourceWithConfigure = &KumaTokenResource{}
+var _ resource.ResourceWithModifyPlan = &KumaTokenResource{}
+var _ ephemeral.EphemeralResource = &KumaTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &KumaTokenEphemeralResource{}
+
+// tokenDefinition describes a kind of token generated by the control-plane.
+type tokenDefinition struct {
+	// typeName is the suffix of the terraform type (e.g. `zone_token` for `kuma_zone_token`).
+	typeName string
+	// markdownDescription describes the token, the resource and the ephemeral resource explain how it's kept.
+	markdownDescription string
+	// attributes and ephemeralAttributes are the attributes of the request besides `valid_for`.
+	attributes          map[string]schema.Attribute
+	ephemeralAttributes map[string]ephemeralschema.Attribute
+	// generate requests a token with the attributes of the plan or the config.
+	generate func(ctx context.Context, client kumaapi.Client, data tokenRequestData) (string, diag.Diagnostics)
+}
+
+// tokenRequestData is the plan or the config of a token.
+type tokenRequestData interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+func newKumaTokenResource(definition tokenDefinition) func() resource.Resource {
+	return func() resource.Resource {
+		return &KumaTokenResource{definition: definition}
+	}
+}
+
+func newKumaTokenEphemeralResource(definition tokenDefinition) func() ephemeral.EphemeralResource {
+	return func() ephemeral.EphemeralResource {
+		return &KumaTokenEphemeralResource{definition: definition}
+	}
+}
+
+// KumaTokenResource generates a token and keeps it in the state until it expires.
+type KumaTokenResource struct {
+	definition tokenDefinition
+	client     kumaapi.Client
+}
+
+func (r *KumaTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.definition.typeName
+}
+
+func (r *KumaTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"valid_for": schema.StringAttribute{
+			MarkdownDescription: "Validity of the token as a duration (e.g. `720h`)",
+			Required:            true,
+			Validators: []validator.String{
+				durationValidator{},
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"renew_before": schema.StringAttribute{
+			MarkdownDescription: "Generate a new token when the current one expires within this duration (e.g. `72h`), defaults to generating it once expired",
+			Optional:            true,
+			Validators: []validator.String{
+				durationValidator{},
+			},
+		},
+		"token": schema.StringAttribute{
+			MarkdownDescription: "The token",
+			Computed:            true,
+			Sensitive:           true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"expires_at": schema.StringAttribute{
+			MarkdownDescription: "Expiration time of the token",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+	for name, attribute := range r.definition.attributes {
+		attributes[name] = attribute
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: r.definition.markdownDescription + " " +
+			fmt.Sprintf("The token is stored in the state, prefer the `kuma_%s` ephemeral resource with Terraform >= 1.10. ", r.definition.typeName) +
+			"A new token is generated on plan when the current one expires within `renew_before`, destroying the resource doesn't revoke the token.",
+		Attributes: attributes,
+	}
+}
+
+func (r *KumaTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, _, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *KumaTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planTokenRenewal(ctx, req, resp)
+}
+
+func (r *KumaTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	token, diags := r.definition.generate(ctx, r.client, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the plan with the token into Terraform state
+	resp.State.Raw = req.Plan.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("token"), types.StringValue(token))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("expires_at"), tokenExpiration(token))...)
+}
+
+func (r *KumaTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The control-plane doesn't keep the tokens, the state is the only copy.
+}
+
+func (r *KumaTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only renew_before can change without replacing the token.
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (r *KumaTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Tokens can't be deleted, they are only revoked with the revocation secrets of the control-plane.
+}
+
+// KumaTokenEphemeralResource generates a token which is never stored in the state.
+type KumaTokenEphemeralResource struct {
+	definition tokenDefinition
+	client     kumaapi.Client
+}
+
+func (e *KumaTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + e.definition.typeName
+}
+
+func (e *KumaTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := map[string]ephemeralschema.Attribute{
+		"valid_for": ephemeralschema.StringAttribute{
+			MarkdownDescription: "Validity of the token as a duration (e.g. `720h`)",
+			Required:            true,
+			Validators: []validator.String{
+				durationValidator{},
+			},
+		},
+		"token": ephemeralschema.StringAttribute{
+			MarkdownDescription: "The token",
+			Computed:            true,
+			Sensitive:           true,
+		},
+		"expires_at": ephemeralschema.StringAttribute{
+			MarkdownDescription: "Expiration time of the token",
+			Computed:            true,
+		},
+	}
+	for name, attribute := range e.definition.ephemeralAttributes {
+		attributes[name] = attribute
+	}
+	resp.Schema = ephemeralschema.Schema{
+		MarkdownDescription: e.definition.markdownDescription + " " +
+			"A new token is generated on every run and it's never stored in the state or the plan. Requires Terraform >= 1.10.",
+		Attributes: attributes,
+	}
+}
+
+func (e *KumaTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, _, diags := configureClient(ctx, req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	e.client = client
+}
+
+func (e *KumaTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	token, diags := e.definition.generate(ctx, e.client, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the configuration with the token into the ephemeral result
+	resp.Result.Raw = req.Config.Raw
+	resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root("token"), types.StringValue(token))...)
+	resp.Diagnostics.Append(resp.Result.SetAttribute(ctx, path.Root("expires_at"), tokenExpiration(token))...)
+}
+
+// durationValidator checks that a string is a duration like `24h`.
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a duration like `24h`"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Unknown values are validated once they are known, on apply.
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	var d time.Duration
+	resp.Diagnostics.Append(parseDuration(req.ConfigValue, req.Path, &d)...)
+}
+
+// tokenFieldPath maps the fields of a token request rejected by the control-plane to their attribute.
+func tokenFieldPath(field string) path.Path {
+	switch field {
+	case "validFor":
+		return path.Root("valid_for")
+	case "":
+		return path.Empty()
+	}
+	return path.Root(field)
+}
+
+// tokenExpiration returns the expiration of a token, null when it can't be read from the token.
+func tokenExpiration(token string) types.String {
+	expiration, ok := kumaapi.TokenExpiration(token)
+	if !ok {
+		return types.StringNull()
+	}
+	return timeValue(&expiration)
+}
+
+// planTokenRenewal replaces a token resource when it expires within `renew_before`, tokens can't be refreshed.
+func planTokenRenewal(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Skip when creating or deleting
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var expiresAt, renewBefore types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("renew_before"), &renewBefore)...)
+	if resp.Diagnostics.HasError() || expiresAt.IsNull() || renewBefore.IsUnknown() {
+		return
+	}
+	expiration, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil {
+		return
+	}
+	var margin time.Duration
+	resp.Diagnostics.Append(parseDuration(renewBefore, path.Root("renew_before"), &margin)...)
+	if resp.Diagnostics.HasError() || time.Now().Add(margin).Before(expiration) {
+		return
+	}
+	tflog.Info(ctx, "token expires soon, regenerating it", map[string]interface{}{
+		"expires_at":   expiresAt.ValueString(),
+		"renew_before": margin.String(),
+	})
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("token"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+}
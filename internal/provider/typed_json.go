@@ -0,0 +1,436 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// jsonSuffix marks string attributes which hold a free form json document (e.g. `conf_json`).
+const jsonSuffix = "_json"
+
+// jsonConverter converts terraform values into kuma json and back by walking the schema.
+// Attribute names are the snake_case version of the json keys, names holds the exceptions.
+type jsonConverter struct {
+	names map[string]string
+}
+
+func (c jsonConverter) jsonName(attrName string) string {
+	if n, ok := c.names[attrName]; ok {
+		return n
+	}
+	parts := strings.Split(strings.TrimSuffix(attrName, jsonSuffix), "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// objectToJSON converts an object described by attributes to a json map, null attributes are omitted.
+func (c jsonConverter) objectToJSON(attributes map[string]schema.Attribute, v tftypes.Value) (map[string]interface{}, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return nil, nil
+	}
+	values := map[string]tftypes.Value{}
+	if err := v.As(&values); err != nil {
+		return nil, err
+	}
+	out := map[string]interface{}{}
+	for name, a := range attributes {
+		attrValue, ok := values[name]
+		if !ok || attrValue.IsNull() || !attrValue.IsKnown() {
+			continue
+		}
+		res, err := c.attributeToJSON(name, a, attrValue)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		out[c.jsonName(name)] = res
+	}
+	return out, nil
+}
+
+func (c jsonConverter) attributeToJSON(name string, a schema.Attribute, v tftypes.Value) (interface{}, error) {
+	switch a := a.(type) {
+	case schema.SingleNestedAttribute:
+		return c.objectToJSON(a.Attributes, v)
+	case schema.ListNestedAttribute:
+		var items []tftypes.Value
+		if err := v.As(&items); err != nil {
+			return nil, err
+		}
+		out := make([]interface{}, 0, len(items))
+		for i, item := range items {
+			res, err := c.objectToJSON(a.NestedObject.Attributes, item)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			out = append(out, res)
+		}
+		return out, nil
+	case schema.MapNestedAttribute:
+		items := map[string]tftypes.Value{}
+		if err := v.As(&items); err != nil {
+			return nil, err
+		}
+		out := map[string]interface{}{}
+		for k, item := range items {
+			res, err := c.objectToJSON(a.NestedObject.Attributes, item)
+			if err != nil {
+				return nil, fmt.Errorf("[%s]: %w", k, err)
+			}
+			out[k] = res
+		}
+		return out, nil
+	case schema.StringAttribute:
+		var s string
+		if err := v.As(&s); err != nil {
+			return nil, err
+		}
+		if !strings.HasSuffix(name, jsonSuffix) {
+			return s, nil
+		}
+		var out interface{}
+		if err := json.Unmarshal([]byte(s), &out); err != nil {
+			return nil, fmt.Errorf("invalid json: %w", err)
+		}
+		return out, nil
+	default:
+		return valueToJSON(v)
+	}
+}
+
+// valueToJSON converts primitive values and collections of primitive values.
+func valueToJSON(v tftypes.Value) (interface{}, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return nil, nil
+	}
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+		return s, err
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+		return b, err
+	case v.Type().Is(tftypes.Number):
+		f := big.NewFloat(0)
+		if err := v.As(&f); err != nil {
+			return nil, err
+		}
+		return json.Number(f.Text('f', -1)), nil
+	case v.Type().Is(tftypes.List{}), v.Type().Is(tftypes.Set{}):
+		var items []tftypes.Value
+		if err := v.As(&items); err != nil {
+			return nil, err
+		}
+		out := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			res, err := valueToJSON(item)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, res)
+		}
+		return out, nil
+	case v.Type().Is(tftypes.Map{}):
+		items := map[string]tftypes.Value{}
+		if err := v.As(&items); err != nil {
+			return nil, err
+		}
+		out := map[string]interface{}{}
+		for k, item := range items {
+			res, err := valueToJSON(item)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = res
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// objectFromJSON builds the terraform value of an object described by attributes, unknown json keys are ignored.
+func (c jsonConverter) objectFromJSON(ctx context.Context, attributes map[string]schema.Attribute, m map[string]interface{}) (tftypes.Value, error) {
+	typ := objectType(ctx, attributes)
+	if m == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	values := map[string]tftypes.Value{}
+	for name, a := range attributes {
+		v, err := c.attributeFromJSON(ctx, name, a, m[c.jsonName(name)])
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+		}
+		values[name] = v
+	}
+	return tftypes.NewValue(typ, values), nil
+}
+
+func (c jsonConverter) attributeFromJSON(ctx context.Context, name string, a schema.Attribute, raw interface{}) (tftypes.Value, error) {
+	typ := a.GetType().TerraformType(ctx)
+	if raw == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	switch a := a.(type) {
+	case schema.SingleNestedAttribute:
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected an object got %T", raw)
+		}
+		return c.objectFromJSON(ctx, a.Attributes, m)
+	case schema.ListNestedAttribute:
+		items, ok := raw.([]interface{})
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected a list got %T", raw)
+		}
+		var values []tftypes.Value
+		for i, item := range items {
+			m, _ := item.(map[string]interface{})
+			v, err := c.objectFromJSON(ctx, a.NestedObject.Attributes, m)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("[%d]: %w", i, err)
+			}
+			values = append(values, v)
+		}
+		return tftypes.NewValue(typ, values), nil
+	case schema.MapNestedAttribute:
+		items, ok := raw.(map[string]interface{})
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected an object got %T", raw)
+		}
+		values := map[string]tftypes.Value{}
+		for k, item := range items {
+			m, _ := item.(map[string]interface{})
+			v, err := c.objectFromJSON(ctx, a.NestedObject.Attributes, m)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("[%s]: %w", k, err)
+			}
+			values[k] = v
+		}
+		return tftypes.NewValue(typ, values), nil
+	case schema.StringAttribute:
+		if strings.HasSuffix(name, jsonSuffix) {
+			b, err := json.Marshal(raw)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			return tftypes.NewValue(typ, string(b)), nil
+		}
+	}
+	return valueFromJSON(typ, raw)
+}
+
+func valueFromJSON(typ tftypes.Type, raw interface{}) (tftypes.Value, error) {
+	if raw == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	switch {
+	case typ.Is(tftypes.String):
+		switch r := raw.(type) {
+		case string:
+			return tftypes.NewValue(typ, r), nil
+		case json.Number:
+			return tftypes.NewValue(typ, r.String()), nil
+		case bool:
+			return tftypes.NewValue(typ, fmt.Sprintf("%t", r)), nil
+		}
+	case typ.Is(tftypes.Bool):
+		if b, ok := raw.(bool); ok {
+			return tftypes.NewValue(typ, b), nil
+		}
+	case typ.Is(tftypes.Number):
+		switch r := raw.(type) {
+		case json.Number:
+			f, _, err := big.ParseFloat(r.String(), 10, 512, big.ToNearestEven)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			return tftypes.NewValue(typ, f), nil
+		case float64:
+			return tftypes.NewValue(typ, big.NewFloat(r)), nil
+		}
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}):
+		items, ok := raw.([]interface{})
+		if !ok {
+			break
+		}
+		var values []tftypes.Value
+		for _, item := range items {
+			v, err := valueFromJSON(elementType(typ), item)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			values = append(values, v)
+		}
+		return tftypes.NewValue(typ, values), nil
+	case typ.Is(tftypes.Map{}):
+		items, ok := raw.(map[string]interface{})
+		if !ok {
+			break
+		}
+		values := map[string]tftypes.Value{}
+		for k, item := range items {
+			v, err := valueFromJSON(elementType(typ), item)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			values[k] = v
+		}
+		return tftypes.NewValue(typ, values), nil
+	}
+	return tftypes.Value{}, fmt.Errorf("can't convert %T to %s", raw, typ)
+}
+
+func elementType(typ tftypes.Type) tftypes.Type {
+	switch t := typ.(type) {
+	case tftypes.List:
+		return t.ElementType
+	case tftypes.Set:
+		return t.ElementType
+	case tftypes.Map:
+		return t.ElementType
+	}
+	return tftypes.DynamicPseudoType
+}
+
+func objectType(ctx context.Context, attributes map[string]schema.Attribute) tftypes.Object {
+	attrTypes := map[string]tftypes.Type{}
+	for name, a := range attributes {
+		attrTypes[name] = a.GetType().TerraformType(ctx)
+	}
+	return tftypes.Object{AttributeTypes: attrTypes}
+}
+
+// onlyManagedFields keeps from the server's version of a resource only what is present in prior
+// so that fields defaulted by the control-plane don't show up as changes.
+// Values which are only a different spelling of the same duration are kept as in prior, so are the unorderedLists
+// holding the same values in a different order. The order of other lists isn't ignored, Kuma applies rules
+// (e.g. `to`, `from` or the http route matches) and headers in their order.
+func onlyManagedFields(server interface{}, prior interface{}) interface{} {
+	if prior == nil {
+		return server
+	}
+	if server == nil {
+		// The control-plane omits zero values.
+		if isZero(prior) {
+			return prior
+		}
+		return nil
+	}
+	switch p := prior.(type) {
+	case map[string]interface{}:
+		s, ok := server.(map[string]interface{})
+		if !ok {
+			return server
+		}
+		out := map[string]interface{}{}
+		for k, v := range p {
+			if unorderedLists[k] && sameScalars(s[k], v) {
+				out[k] = v
+				continue
+			}
+			if res := onlyManagedFields(s[k], v); res != nil {
+				out[k] = res
+			}
+		}
+		return out
+	case []interface{}:
+		s, ok := server.([]interface{})
+		if !ok {
+			return server
+		}
+		out := make([]interface{}, 0, len(s))
+		for i, v := range s {
+			if i < len(p) {
+				v = onlyManagedFields(v, p[i])
+			}
+			out = append(out, v)
+		}
+		return out
+	case string:
+		if s, ok := server.(string); ok && sameDuration(s, p) {
+			return prior
+		}
+	}
+	return server
+}
+
+// unorderedLists are the json keys of the lists of scalars with set semantics, whose order isn't kept by the control-plane.
+var unorderedLists = map[string]bool{
+	"retryOn":                     true,
+	"proxyTypes":                  true,
+	"skipCreatingInitialPolicies": true,
+}
+
+// sameScalars returns true when both values are lists only holding the same scalars, ignoring their order.
+func sameScalars(x interface{}, y interface{}) bool {
+	a, ok := x.([]interface{})
+	if !ok {
+		return false
+	}
+	b, ok := y.([]interface{})
+	if !ok || len(a) != len(b) {
+		return false
+	}
+	counts := map[interface{}]int{}
+	for _, v := range a {
+		switch v.(type) {
+		case string, bool, json.Number:
+			counts[v]++
+		default:
+			return false
+		}
+	}
+	for _, v := range b {
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
+	}
+	return true
+}
+
+func isZero(v interface{}) bool {
+	switch v := v.(type) {
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case json.Number:
+		f, err := v.Float64()
+		return err == nil && f == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		for _, item := range v {
+			if !isZero(item) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func sameDuration(a string, b string) bool {
+	da, err := time.ParseDuration(a)
+	if err != nil {
+		return false
+	}
+	db, err := time.ParseDuration(b)
+	return err == nil && da == db
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func decodeJSON(t *testing.T, s string) map[string]interface{} {
+	t.Helper()
+	out := map[string]interface{}{}
+	d := json.NewDecoder(bytes.NewReader([]byte(s)))
+	d.UseNumber()
+	if err := d.Decode(&out); err != nil {
+		t.Fatalf("invalid json: %s", err)
+	}
+	return out
+}
+
+func TestJSONConverterRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	attributes := meshAttributes()
+	c := jsonConverter{names: map[string]string{"rsa_bits": "RSAbits"}}
+	in := decodeJSON(t, `{
+		"skipCreatingInitialPolicies": ["*"],
+		"mtls": {
+			"enabledBackend": "ca-1",
+			"backends": [{"name": "ca-1", "mode": "STRICT", "builtin": {"caCert": {"RSAbits": 2048, "expiration": "10y"}}, "dpCert": {"rotation": {"expiration": "1d"}}}]
+		},
+		"routing": {"zoneEgress": true},
+		"constraints": {"dataplaneProxy": {"requirements": [{"tags": {"kuma.io/zone": "east"}}]}},
+		"tracing": {"backends": [{"name": "zipkin", "type": "zipkin", "sampling": 12.5, "conf": {"url": "http://zipkin:9411"}}]}
+	}`)
+	v, err := c.objectFromJSON(ctx, attributes, in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	out, err := c.objectToJSON(attributes, v)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(in, out); diff != "" {
+		t.Errorf("round trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMeshBackendsTransform(t *testing.T) {
+	m := decodeJSON(t, `{"mtls": {"backends": [{"name": "ca-1", "builtin": {}}, {"name": "ca-2", "provided": {"cert": {"secret": "cert"}}}]}}`)
+	meshToKuma(m)
+	want := decodeJSON(t, `{"mtls": {"backends": [{"name": "ca-1", "type": "builtin", "conf": {}}, {"name": "ca-2", "type": "provided", "conf": {"cert": {"secret": "cert"}}}]}}`)
+	if diff := cmp.Diff(want, m); diff != "" {
+		t.Errorf("toKuma mismatch (-want +got):\n%s", diff)
+	}
+	if err := meshFromKuma(m); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want = decodeJSON(t, `{"mtls": {"backends": [{"name": "ca-1", "builtin": {}}, {"name": "ca-2", "provided": {"cert": {"secret": "cert"}}}]}}`)
+	if diff := cmp.Diff(want, m); diff != "" {
+		t.Errorf("fromKuma mismatch (-want +got):\n%s", diff)
+	}
+
+	unsupported := decodeJSON(t, `{"mtls": {"backends": [{"name": "ca-1", "type": "custom", "conf": {"key": "value"}}]}}`)
+	if err := meshFromKuma(unsupported); err == nil {
+		t.Errorf("expected an error for the unsupported backend type")
+	}
+}
+
+func TestOnlyManagedFields(t *testing.T) {
+	tests := map[string]struct {
+		server string
+		prior  string
+		want   string
+	}{
+		"server defaults are dropped": {
+			server: `{"name": "a", "labels": {"kuma.io/origin": "global", "team": "x"}, "routing": {"zoneEgress": true, "localityAwareLoadBalancing": false}}`,
+			prior:  `{"name": "a", "labels": {"team": "x"}, "routing": {"zoneEgress": false}}`,
+			want:   `{"name": "a", "labels": {"team": "x"}, "routing": {"zoneEgress": true}}`,
+		},
+		"omitted zero values are kept": {
+			server: `{"name": "a"}`,
+			prior:  `{"name": "a", "skipCreatingInitialPolicies": [], "routing": {"zoneEgress": false}}`,
+			want:   `{"name": "a", "skipCreatingInitialPolicies": [], "routing": {"zoneEgress": false}}`,
+		},
+		"equivalent durations are kept": {
+			server: `{"timeout": "60s", "other": "2s"}`,
+			prior:  `{"timeout": "1m", "other": "1s"}`,
+			want:   `{"timeout": "1m", "other": "2s"}`,
+		},
+		"reordered scalars are kept": {
+			server: `{"retryOn": ["5xx", "reset", "gateway-error"]}`,
+			prior:  `{"retryOn": ["gateway-error", "5xx", "reset"]}`,
+			want:   `{"retryOn": ["gateway-error", "5xx", "reset"]}`,
+		},
+		"reordered ordered scalars are drift": {
+			server: `{"tlsCiphers": ["ECDHE-RSA-AES128-GCM-SHA256", "ECDHE-ECDSA-AES256-GCM-SHA384"]}`,
+			prior:  `{"tlsCiphers": ["ECDHE-ECDSA-AES256-GCM-SHA384", "ECDHE-RSA-AES128-GCM-SHA256"]}`,
+			want:   `{"tlsCiphers": ["ECDHE-RSA-AES128-GCM-SHA256", "ECDHE-ECDSA-AES256-GCM-SHA384"]}`,
+		},
+		"reordered objects are drift": {
+			server: `{"rules": [{"path": "/b"}, {"path": "/a"}]}`,
+			prior:  `{"rules": [{"path": "/a"}, {"path": "/b"}]}`,
+			want:   `{"rules": [{"path": "/b"}, {"path": "/a"}]}`,
+		},
+		"different scalars are drift": {
+			server: `{"retryOn": ["5xx", "5xx"]}`,
+			prior:  `{"retryOn": ["5xx", "reset"]}`,
+			want:   `{"retryOn": ["5xx", "5xx"]}`,
+		},
+		"extra list items are drift": {
+			server: `{"items": [{"a": "1", "b": "2"}, {"a": "3"}]}`,
+			prior:  `{"items": [{"a": "1"}]}`,
+			want:   `{"items": [{"a": "1"}, {"a": "3"}]}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := onlyManagedFields(decodeJSON(t, tt.server), decodeJSON(t, tt.prior))
+			if diff := cmp.Diff(decodeJSON(t, tt.want), got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJSONConverterPolicyRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	attributes := meshLoadBalancingStrategyAttributes()
+	c := jsonConverter{names: map[string]string{"source_ip": "sourceIP"}}
+	in := decodeJSON(t, `{
+		"spec": {
+			"targetRef": {"kind": "Mesh"},
+			"to": [{
+				"targetRef": {"kind": "MeshService", "name": "backend"},
+				"default": {
+					"loadBalancer": {"type": "RingHash", "ringHash": {"hashPolicies": [{"type": "Connection", "connection": {"sourceIP": true}}]}},
+					"localityAwareness": {"crossZone": {"failoverThreshold": {"percentage": "70"}}}
+				}
+			}]
+		}
+	}`)
+	v, err := c.objectFromJSON(ctx, attributes, in)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	out, err := c.objectToJSON(attributes, v)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(in, out); diff != "" {
+		t.Errorf("round trip mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// supportedVersions are the Kuma versions the provider is tested with, Kong Mesh is checked with the Kuma version
+// it's based on.
+var supportedVersions = version.MustConstraints(version.NewConstraint(">= 2.5.0, < 2.10.0"))
+
+// resourceVersions are the Kuma versions introducing resource types, types which predate the supported versions
+// are omitted.
+var resourceVersions = map[string]string{
+	"MeshMetric":           "2.6.0",
+	"MeshTLS":              "2.9.0",
+	"MeshPassthrough":      "2.9.0",
+	"MeshService":          "2.9.0",
+	"MeshExternalService":  "2.9.0",
+	"MeshMultiZoneService": "2.9.0",
+	"HostnameGenerator":    "2.9.0",
+}
+
+const (
+	// targetRefLabelsVersion introduced the selection of resources by `labels` in a `targetRef`.
+	targetRefLabelsVersion = "2.9.0"
+	// originLabelVersion introduced the `kuma.io/origin` label on the resources created on a zone.
+	originLabelVersion = "2.7.0"
+	// rulesInspectVersion introduced the inspect api of the dataplanes (`_rules`) and of the policies
+	// (`_resources/dataplanes`).
+	rulesInspectVersion = "2.6.0"
+)
+
+// checkVersion warns when the control-plane isn't in the supported versions.
+func checkVersion(metadata kumaapi.Metadata) diag.Diagnostics {
+	var diags diag.Diagnostics
+	v := metadata.KumaVersion()
+	switch {
+	case v == nil:
+		diags.AddWarning("unknown control-plane version",
+			fmt.Sprintf("The version `%s` of %s can't be parsed, the provider doesn't check that the resources are supported by the control-plane.", metadata.Version, metadata.Product))
+	case !supportedVersions.Check(v):
+		diags.AddWarning("untested control-plane version",
+			fmt.Sprintf("%s is not tested with this provider (supported Kuma versions: %s), some resources may not work as expected.", describeControlPlane(metadata), supportedVersions))
+	}
+	return diags
+}
+
+// checkFeatures returns an error for every feature used by the resource which isn't supported by the control-plane,
+// attribute converts the kuma field (e.g. `spec.targetRef.labels`) of the feature to the path of the diagnostic.
+func checkFeatures(metadata kumaapi.Metadata, resType string, doc map[string]interface{}, attribute func(field string) path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	v := metadata.KumaVersion()
+	if v == nil {
+		return diags
+	}
+	require := func(field string, feature string, minVersion string) {
+		if v.LessThan(version.Must(version.NewVersion(minVersion))) {
+			diags.AddAttributeError(attribute(field), "unsupported by the control-plane",
+				fmt.Sprintf("%s requires Kuma >= %s, the control-plane is %s", feature, minVersion, describeControlPlane(metadata)))
+		}
+	}
+	if minVersion, ok := resourceVersions[resType]; ok {
+		require("", resType, minVersion)
+	}
+	if labels, ok := doc["labels"].(map[string]interface{}); ok {
+		if _, ok := labels["kuma.io/origin"]; ok {
+			require("labels", "The `kuma.io/origin` label", originLabelVersion)
+		}
+	}
+	for _, field := range targetRefsWithLabels("", doc) {
+		require(field, "Selecting resources with `labels` in a `targetRef`", targetRefLabelsVersion)
+	}
+	return diags
+}
+
+// requireVersion returns an error when the control-plane is older than minVersion, unknown versions are accepted.
+func requireVersion(metadata kumaapi.Metadata, feature string, minVersion string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	v := metadata.KumaVersion()
+	if v != nil && v.LessThan(version.Must(version.NewVersion(minVersion))) {
+		diags.AddError("unsupported by the control-plane",
+			fmt.Sprintf("%s requires Kuma >= %s, the control-plane is %s", feature, minVersion, describeControlPlane(metadata)))
+	}
+	return diags
+}
+
+// targetRefsWithLabels returns the fields of the `targetRef` using labels in v.
+func targetRefsWithLabels(field string, v interface{}) []string {
+	var out []string
+	switch v := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			child := strings.TrimPrefix(field+"."+k, ".")
+			if ref, ok := v[k].(map[string]interface{}); ok && k == "targetRef" {
+				if _, ok := ref["labels"]; ok {
+					out = append(out, child+".labels")
+				}
+				continue
+			}
+			out = append(out, targetRefsWithLabels(child, v[k])...)
+		}
+	case []interface{}:
+		for i, item := range v {
+			out = append(out, targetRefsWithLabels(fmt.Sprintf("%s[%d]", field, i), item)...)
+		}
+	}
+	return out
+}
+
+func describeControlPlane(metadata kumaapi.Metadata) string {
+	if metadata.BasedOnKuma != "" {
+		return fmt.Sprintf("%s %s (Kuma %s)", metadata.Product, metadata.Version, metadata.BasedOnKuma)
+	}
+	return fmt.Sprintf("%s %s", metadata.Product, metadata.Version)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/Kong/terraform-provider-kuma/internal/kumaapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestCheckVersion(t *testing.T) {
+	tests := map[string]struct {
+		metadata kumaapi.Metadata
+		want     diag.Diagnostics
+	}{
+		"supported": {
+			metadata: kumaapi.Metadata{Product: "Kuma", Version: "2.7.3"},
+		},
+		"supported kong mesh": {
+			metadata: kumaapi.Metadata{Product: "Kong Mesh", Version: "2.8.2", BasedOnKuma: "2.8.1"},
+		},
+		"untested": {
+			metadata: kumaapi.Metadata{Product: "Kuma", Version: "2.1.0"},
+			want: diag.Diagnostics{
+				diag.NewWarningDiagnostic("untested control-plane version", "Kuma 2.1.0 is not tested with this provider (supported Kuma versions: >= 2.5.0, < 2.10.0), some resources may not work as expected."),
+			},
+		},
+		"dev build": {
+			metadata: kumaapi.Metadata{Product: "Kuma", Version: "dev-1a2b3c"},
+			want: diag.Diagnostics{
+				diag.NewWarningDiagnostic("unknown control-plane version", "The version `dev-1a2b3c` of Kuma can't be parsed, the provider doesn't check that the resources are supported by the control-plane."),
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := checkVersion(tt.metadata); !got.Equal(tt.want) {
+				t.Errorf("expected %v got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCheckFeatures(t *testing.T) {
+	kuma28 := kumaapi.Metadata{Product: "Kong Mesh", Version: "2.8.0", BasedOnKuma: "2.8.0"}
+	fieldPath := func(field string) path.Path {
+		return path.Root(field)
+	}
+	tests := map[string]struct {
+		metadata kumaapi.Metadata
+		resType  string
+		doc      map[string]interface{}
+		want     diag.Diagnostics
+	}{
+		"supported": {
+			metadata: kuma28,
+			resType:  "MeshTimeout",
+			doc: map[string]interface{}{
+				"labels": map[string]interface{}{"kuma.io/origin": "zone"},
+				"spec":   map[string]interface{}{"targetRef": map[string]interface{}{"kind": "Mesh"}},
+			},
+		},
+		"new resource type": {
+			metadata: kuma28,
+			resType:  "MeshTLS",
+			want: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root(""), "unsupported by the control-plane", "MeshTLS requires Kuma >= 2.9.0, the control-plane is Kong Mesh 2.8.0 (Kuma 2.8.0)"),
+			},
+		},
+		"labels in targetRef": {
+			metadata: kuma28,
+			resType:  "MeshTimeout",
+			doc: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"to": []interface{}{
+						map[string]interface{}{"targetRef": map[string]interface{}{"kind": "MeshService", "labels": map[string]interface{}{"app": "web"}}},
+					},
+				},
+			},
+			want: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("spec.to[0].targetRef.labels"), "unsupported by the control-plane", "Selecting resources with `labels` in a `targetRef` requires Kuma >= 2.9.0, the control-plane is Kong Mesh 2.8.0 (Kuma 2.8.0)"),
+			},
+		},
+		"origin label": {
+			metadata: kumaapi.Metadata{Product: "Kuma", Version: "2.6.1"},
+			resType:  "MeshTimeout",
+			doc:      map[string]interface{}{"labels": map[string]interface{}{"kuma.io/origin": "zone"}},
+			want: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("labels"), "unsupported by the control-plane", "The `kuma.io/origin` label requires Kuma >= 2.7.0, the control-plane is Kuma 2.6.1"),
+			},
+		},
+		"unknown version": {
+			metadata: kumaapi.Metadata{Product: "Kuma", Version: "dev-1a2b3c"},
+			resType:  "MeshTLS",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := checkFeatures(tt.metadata, tt.resType, tt.doc, fieldPath); !got.Equal(tt.want) {
+				t.Errorf("expected %v got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestResolveResourceRequiresVersion(t *testing.T) {
+	_, _, diags := resolveResource(kumaapi.Metadata{Product: "Kuma", Version: "2.8.0"}, "MeshService", "default")
+	want := diag.Diagnostics{
+		diag.NewErrorDiagnostic("unsupported resource type", "Resource type 'MeshService' is not supported by the server, it requires Kuma >= 2.9.0 and the control-plane is Kuma 2.8.0"),
+	}
+	if !diags.Equal(want) {
+		t.Errorf("expected %v got %v", want, diags)
+	}
+}
+
+func TestRequireVersion(t *testing.T) {
+	tests := map[string]struct {
+		metadata kumaapi.Metadata
+		want     diag.Diagnostics
+	}{
+		"supported": {
+			metadata: kumaapi.Metadata{Product: "Kuma", Version: "2.6.0"},
+		},
+		"unknown version": {
+			metadata: kumaapi.Metadata{Product: "Kuma", Version: "dev-1a2b3c"},
+		},
+		"too old": {
+			metadata: kumaapi.Metadata{Product: "Kuma", Version: "2.5.3"},
+			want: diag.Diagnostics{
+				diag.NewErrorDiagnostic("unsupported by the control-plane", "Inspecting the policies of a dataplane requires Kuma >= 2.6.0, the control-plane is Kuma 2.5.3"),
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := requireVersion(tt.metadata, "Inspecting the policies of a dataplane", rulesInspectVersion); !got.Equal(tt.want) {
+				t.Errorf("expected %v got %v", tt.want, got)
+			}
+		})
+	}
+}
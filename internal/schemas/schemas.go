@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schemas holds the OpenAPI schemas of the kuma policies vendored from the kuma repository.
+package schemas
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed policies/*.yaml
+var policies embed.FS
+
+// Document is an OpenAPI document describing a single kuma resource type.
+type Document struct {
+	Info struct {
+		Name string `yaml:"x-ref-schema-name"`
+	} `yaml:"info"`
+	Components struct {
+		Schemas map[string]*Property `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+// Property is the subset of OpenAPI used by the kuma schemas.
+type Property struct {
+	Description          string               `yaml:"description"`
+	Type                 string               `yaml:"type"`
+	Format               string               `yaml:"format"`
+	Enum                 []interface{}        `yaml:"enum"`
+	Properties           map[string]*Property `yaml:"properties"`
+	Required             []string             `yaml:"required"`
+	Items                *Property            `yaml:"items"`
+	AdditionalProperties *Property            `yaml:"additionalProperties"`
+	AnyOf                []*Property          `yaml:"anyOf"`
+	OneOf                []*Property          `yaml:"oneOf"`
+	PreserveUnknown      bool                 `yaml:"x-kubernetes-preserve-unknown-fields"`
+	IntOrString          bool                 `yaml:"x-kubernetes-int-or-string"`
+}
+
+// IsRequired returns whether the property name of this object is required.
+func (p *Property) IsRequired(name string) bool {
+	for _, r := range p.Required {
+		if r == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFreeForm returns whether the property accepts any json (e.g. `x-kubernetes-preserve-unknown-fields`).
+func (p *Property) IsFreeForm() bool {
+	return p.PreserveUnknown || len(p.OneOf) > 0 || (p.Type == "object" && p.Properties == nil && p.AdditionalProperties == nil)
+}
+
+// IsIntOrString returns whether the property accepts both integers and strings.
+func (p *Property) IsIntOrString() bool {
+	return p.IntOrString || len(p.AnyOf) > 0
+}
+
+// Parse reads an OpenAPI document and returns its name and the schema of its item.
+func Parse(data []byte) (string, *Property, error) {
+	doc := Document{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return "", nil, err
+	}
+	name := doc.Info.Name
+	item, ok := doc.Components.Schemas[name+"Item"]
+	if !ok {
+		return "", nil, fmt.Errorf("schema %sItem not found", name)
+	}
+	return name, item, nil
+}
+
+var (
+	loadOnce sync.Once
+	loaded   map[string]*Property
+	loadErr  error
+)
+
+// Policy returns the schema of the vendored kuma policy type (e.g. `MeshTimeout`).
+func Policy(kumaType string) (*Property, bool, error) {
+	loadOnce.Do(func() {
+		loaded, loadErr = load()
+	})
+	if loadErr != nil {
+		return nil, false, loadErr
+	}
+	p, ok := loaded[kumaType]
+	return p, ok, nil
+}
+
+func load() (map[string]*Property, error) {
+	entries, err := policies.ReadDir("policies")
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]*Property{}
+	for _, e := range entries {
+		data, err := policies.ReadFile(path.Join("policies", e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		name, item, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema %s: %w", e.Name(), err)
+		}
+		out[name] = item
+	}
+	return out, nil
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemas
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ValidationError is a violation of the schema at the json pointer Pointer (RFC 6901).
+type ValidationError struct {
+	Pointer string
+	Message string
+	// UnknownField is true when the field isn't in the schema, it may have been added by a newer version of Kuma than
+	// the vendored schemas.
+	UnknownField bool
+	// Suggestion is the known field close to an unknown field, it's most likely a typo of it.
+	Suggestion string
+}
+
+func (e ValidationError) Error() string {
+	pointer := e.Pointer
+	if pointer == "" {
+		pointer = "/"
+	}
+	return fmt.Sprintf("%s: %s", pointer, e.Message)
+}
+
+// Validate checks the json document v (decoded with `UseNumber`) against the schema.
+func (p *Property) Validate(v interface{}) []ValidationError {
+	return p.validate("", v)
+}
+
+func (p *Property) validate(pointer string, v interface{}) []ValidationError {
+	if v == nil || p.IsFreeForm() {
+		return nil
+	}
+	if p.IsIntOrString() {
+		switch v.(type) {
+		case string, json.Number, float64:
+			return nil
+		}
+		return invalid(pointer, "must be an integer or a string")
+	}
+	switch p.Type {
+	case "object":
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return invalid(pointer, "must be an object")
+		}
+		return p.validateObject(pointer, m)
+	case "array":
+		items, ok := v.([]interface{})
+		if !ok {
+			return invalid(pointer, "must be an array")
+		}
+		var errs []ValidationError
+		if p.Items != nil {
+			for i, item := range items {
+				errs = append(errs, p.Items.validate(fmt.Sprintf("%s/%d", pointer, i), item)...)
+			}
+		}
+		return errs
+	case "string":
+		if _, ok := v.(string); !ok {
+			return invalid(pointer, "must be a string")
+		}
+	case "integer":
+		if !isInteger(v) {
+			return invalid(pointer, "must be an integer")
+		}
+	case "number":
+		switch v.(type) {
+		case json.Number, float64:
+		default:
+			return invalid(pointer, "must be a number")
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return invalid(pointer, "must be a boolean")
+		}
+	}
+	return p.validateEnum(pointer, v)
+}
+
+func (p *Property) validateObject(pointer string, m map[string]interface{}) []ValidationError {
+	var errs []ValidationError
+	for _, r := range p.Required {
+		if _, ok := m[r]; !ok {
+			errs = append(errs, ValidationError{Pointer: pointer, Message: fmt.Sprintf("missing required field `%s`", r)})
+		}
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		childPointer := pointer + "/" + escape(k)
+		switch {
+		case p.Properties[k] != nil:
+			errs = append(errs, p.Properties[k].validate(childPointer, m[k])...)
+		case p.AdditionalProperties != nil:
+			errs = append(errs, p.AdditionalProperties.validate(childPointer, m[k])...)
+		case p.Properties != nil:
+			suggestion := closestField(k, p.Properties)
+			message := fmt.Sprintf("unknown field `%s`", k)
+			if suggestion != "" {
+				message = fmt.Sprintf("%s, did you mean `%s`?", message, suggestion)
+			}
+			errs = append(errs, ValidationError{Pointer: childPointer, Message: message, UnknownField: true, Suggestion: suggestion})
+		}
+	}
+	return errs
+}
+
+func (p *Property) validateEnum(pointer string, v interface{}) []ValidationError {
+	if len(p.Enum) == 0 {
+		return nil
+	}
+	values := make([]string, 0, len(p.Enum))
+	for _, e := range p.Enum {
+		if fmt.Sprint(e) == fmt.Sprint(v) {
+			return nil
+		}
+		values = append(values, fmt.Sprintf("`%v`", e))
+	}
+	return invalid(pointer, fmt.Sprintf("must be one of %s", strings.Join(values, ", ")))
+}
+
+// closestField returns the known field name is likely a typo of, empty when there is none.
+func closestField(name string, known map[string]*Property) string {
+	keys := make([]string, 0, len(known))
+	for k := range known {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if strings.EqualFold(k, name) || levenshtein(k, name) <= 2 {
+			return k
+		}
+	}
+	return ""
+}
+
+func isInteger(v interface{}) bool {
+	switch n := v.(type) {
+	case json.Number:
+		_, err := n.Int64()
+		return err == nil
+	case float64:
+		return n == float64(int64(n))
+	}
+	return false
+}
+
+func invalid(pointer string, message string) []ValidationError {
+	return []ValidationError{{Pointer: pointer, Message: message}}
+}
+
+// escape escapes a key to be used in a json pointer.
+func escape(k string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(k, "~", "~0"), "/", "~1")
+}
+
+func levenshtein(a string, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemas
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		kumaType string
+		doc      string
+		want     []ValidationError
+	}{
+		"valid": {
+			kumaType: "MeshTrafficPermission",
+			doc:      `{"type": "MeshTrafficPermission", "name": "mtp", "mesh": "default", "labels": {"kuma.io/zone": "east"}, "spec": {"targetRef": {"kind": "Mesh"}, "from": [{"targetRef": {"kind": "Mesh"}, "default": {"action": "Allow"}}]}}`,
+		},
+		"typo": {
+			kumaType: "MeshTrafficPermission",
+			doc:      `{"type": "MeshTrafficPermission", "name": "mtp", "spec": {"from": [{"targetRef": {"kind": "Mesh"}, "default": {"acton": "Allow"}}]}}`,
+			want:     []ValidationError{{Pointer: "/spec/from/0/default/acton", Message: "unknown field `acton`, did you mean `action`?", UnknownField: true, Suggestion: "action"}},
+		},
+		"unknown field": {
+			kumaType: "MeshTrafficPermission",
+			doc:      `{"type": "MeshTrafficPermission", "name": "mtp", "spec": {"targetRef": {"kind": "Mesh"}, "rules": []}}`,
+			want:     []ValidationError{{Pointer: "/spec/rules", Message: "unknown field `rules`", UnknownField: true}},
+		},
+		"enum and required": {
+			kumaType: "MeshTrafficPermission",
+			doc:      `{"type": "MeshTrafficPermission", "spec": {"from": [{"default": {"action": "Permit"}}]}}`,
+			want: []ValidationError{
+				{Pointer: "", Message: "missing required field `name`"},
+				{Pointer: "/spec/from/0", Message: "missing required field `targetRef`"},
+				{Pointer: "/spec/from/0/default/action", Message: "must be one of `Allow`, `Deny`, `AllowWithShadowDeny`"},
+			},
+		},
+		"types": {
+			kumaType: "MeshRetry",
+			doc:      `{"type": "MeshRetry", "name": "r", "spec": {"to": [{"targetRef": {"kind": "Mesh", "tags": {"a/b": 1}}, "default": {"http": {"numRetries": "3", "retryOn": "5XX"}, "tcp": {"maxConnectAttempt": 1.5}}}]}}`,
+			want: []ValidationError{
+				{Pointer: "/spec/to/0/default/http/numRetries", Message: "must be an integer"},
+				{Pointer: "/spec/to/0/default/http/retryOn", Message: "must be an array"},
+				{Pointer: "/spec/to/0/default/tcp/maxConnectAttempt", Message: "must be an integer"},
+				{Pointer: "/spec/to/0/targetRef/tags/a~1b", Message: "must be a string"},
+			},
+		},
+		"int or string and free form": {
+			kumaType: "MeshAccessLog",
+			doc:      `{"type": "MeshAccessLog", "name": "al", "spec": {"to": [{"targetRef": {"kind": "Mesh"}, "default": {"backends": [{"type": "OpenTelemetry", "openTelemetry": {"endpoint": "otel:4317", "body": {"kvlistValue": {}}}}]}}]}}`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s, ok, err := Policy(tt.kumaType)
+			if err != nil || !ok {
+				t.Fatalf("schema of %s not found: %v", tt.kumaType, err)
+			}
+			doc := map[string]interface{}{}
+			d := json.NewDecoder(bytes.NewReader([]byte(tt.doc)))
+			d.UseNumber()
+			if err := d.Decode(&doc); err != nil {
+				t.Fatalf("invalid json: %s", err)
+			}
+			if diff := cmp.Diff(tt.want, s.Validate(doc)); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyUnknownType(t *testing.T) {
+	_, ok, err := Policy("Mesh")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if ok {
+		t.Errorf("expected no schema for Mesh")
+	}
+}
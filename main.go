@@ -18,6 +18,9 @@ import (
 // ensure the documentation is formatted properly.
 //go:generate terraform fmt -recursive ./examples/
 
+// Generate the typed resources of the policies from their vendored OpenAPI schemas.
+//go:generate go run ./tools/policygen -schemas ./internal/schemas/policies -out ./internal/provider
+
 // Run the docs generation tool, check its repository for more information on how it works and how docs
 // can be customized.
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
@@ -0,0 +1,382 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// policygen generates typed terraform resources from the OpenAPI schemas of kuma policies.
+//
+// Each schema in -schemas results in a `kuma_<policy>_resource_gen.go` file in -out declaring the
+// resource, and `policy_resources_gen.go` lists all of them so the provider can register them.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/Kong/terraform-provider-kuma/internal/schemas"
+)
+
+const header = `// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by policygen; DO NOT EDIT.
+
+package provider
+`
+
+// jsonSuffix must match the suffix used by the provider for attributes holding free form json.
+const jsonSuffix = "_json"
+
+// rootProperties are handled by the typed resource itself.
+var rootProperties = map[string]bool{"type": true, "name": true, "mesh": true, "labels": true}
+
+func main() {
+	schemas := flag.String("schemas", "internal/schemas/policies", "directory holding the OpenAPI schemas of the policies")
+	out := flag.String("out", "internal/provider", "directory where the resources are generated")
+	flag.Parse()
+
+	files, err := filepath.Glob(filepath.Join(*schemas, "*.yaml"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(files) == 0 {
+		log.Fatalf("no schema found in %s", *schemas)
+	}
+	sort.Strings(files)
+
+	var constructors []string
+	for _, file := range files {
+		g, err := generate(file)
+		if err != nil {
+			log.Fatalf("%s: %s", file, err)
+		}
+		if err := write(filepath.Join(*out, "kuma_"+g.typeName+"_resource_gen.go"), g.source()); err != nil {
+			log.Fatal(err)
+		}
+		constructors = append(constructors, g.constructor())
+	}
+
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("\nimport \"github.com/hashicorp/terraform-plugin-framework/resource\"\n\n")
+	b.WriteString("// policyResources are the typed resources generated from the schemas of the policies.\n")
+	b.WriteString("var policyResources = []func() resource.Resource{\n")
+	for _, c := range constructors {
+		fmt.Fprintf(&b, "%s,\n", c)
+	}
+	b.WriteString("}\n")
+	if err := write(filepath.Join(*out, "policy_resources_gen.go"), b.Bytes()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func write(file string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: invalid generated code: %w\n%s", file, err, src)
+	}
+	return os.WriteFile(file, formatted, 0o600)
+}
+
+// generator renders the resource of a single policy.
+type generator struct {
+	kumaType string
+	typeName string
+	// jsonNames are the json keys which aren't the camelCase version of their attribute name.
+	jsonNames map[string]string
+	imports   map[string]bool
+	body      bytes.Buffer
+}
+
+func generate(file string) (*generator, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	name, item, err := schemas.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	spec, ok := item.Properties["spec"]
+	if !ok {
+		return nil, fmt.Errorf("schema %sItem has no spec", name)
+	}
+	for k := range item.Properties {
+		if k != "spec" && !rootProperties[k] {
+			return nil, fmt.Errorf("unsupported root property %q", k)
+		}
+	}
+	g := &generator{
+		kumaType:  name,
+		typeName:  snakeCase(name),
+		jsonNames: map[string]string{},
+		imports:   map[string]bool{},
+	}
+	if err := g.attribute("spec", spec, true); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (g *generator) constructor() string {
+	return "NewKuma" + g.kumaType + "Resource"
+}
+
+func (g *generator) source() []byte {
+	imports := []string{
+		"github.com/hashicorp/terraform-plugin-framework/resource",
+		"github.com/hashicorp/terraform-plugin-framework/resource/schema",
+	}
+	for i := range g.imports {
+		imports = append(imports, i)
+	}
+	sort.Strings(imports)
+
+	attributesFunc := strings.ToLower(g.kumaType[:1]) + g.kumaType[1:] + "Attributes"
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("\nimport (\n")
+	for _, i := range imports {
+		fmt.Fprintf(&b, "%q\n", i)
+	}
+	b.WriteString(")\n\n")
+	fmt.Fprintf(&b, "func %s() resource.Resource {\n", g.constructor())
+	b.WriteString("return newKumaTypedResource(typedResourceDefinition{\n")
+	fmt.Fprintf(&b, "typeName: %q,\n", g.typeName)
+	fmt.Fprintf(&b, "kumaType: %q,\n", g.kumaType)
+	b.WriteString("meshed: true,\n")
+	fmt.Fprintf(&b, "markdownDescription: %q,\n", fmt.Sprintf("A Kuma %s policy, see the [%s documentation](https://kuma.io/docs/latest/policies/%s/).", g.kumaType, g.kumaType, strings.ToLower(g.kumaType)))
+	fmt.Fprintf(&b, "attributes: %s(),\n", attributesFunc)
+	if len(g.jsonNames) > 0 {
+		b.WriteString("jsonNames: map[string]string{\n")
+		keys := make([]string, 0, len(g.jsonNames))
+		for k := range g.jsonNames {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&b, "%q: %q,\n", k, g.jsonNames[k])
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("})()\n}\n\n")
+	fmt.Fprintf(&b, "func %s() map[string]schema.Attribute {\n", attributesFunc)
+	b.WriteString("return map[string]schema.Attribute{\n")
+	b.Write(g.body.Bytes())
+	b.WriteString("}\n}\n")
+	return b.Bytes()
+}
+
+// attribute writes the map entry of the attribute for the json property key.
+func (g *generator) attribute(key string, p *schemas.Property, required bool) error {
+	name := snakeCase(key)
+	freeForm := p.IsFreeForm()
+	if freeForm {
+		name += jsonSuffix
+	}
+	if camelCase(strings.TrimSuffix(name, jsonSuffix)) != key {
+		if existing, ok := g.jsonNames[name]; ok && existing != key {
+			return fmt.Errorf("attribute %s maps to both %s and %s", name, existing, key)
+		}
+		g.jsonNames[name] = key
+	}
+	w := &g.body
+	switch {
+	case freeForm:
+		fmt.Fprintf(w, "%q: schema.StringAttribute{\n", name)
+		g.common(p, required, "A json document")
+	case p.IsIntOrString():
+		fmt.Fprintf(w, "%q: schema.StringAttribute{\n", name)
+		g.common(p, required, "An integer or a string")
+	case p.Type == "object" && p.Properties != nil:
+		fmt.Fprintf(w, "%q: schema.SingleNestedAttribute{\n", name)
+		g.common(p, required, "")
+		if err := g.attributes(p); err != nil {
+			return fmt.Errorf("%s.%w", key, err)
+		}
+	case p.Type == "object":
+		elem := p.AdditionalProperties
+		if elem.Type == "object" && elem.Properties != nil {
+			fmt.Fprintf(w, "%q: schema.MapNestedAttribute{\n", name)
+			g.common(p, required, "")
+			w.WriteString("NestedObject: schema.NestedAttributeObject{\n")
+			if err := g.attributes(elem); err != nil {
+				return fmt.Errorf("%s.%w", key, err)
+			}
+			w.WriteString("},\n")
+			break
+		}
+		elemType, err := elementType(elem)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		fmt.Fprintf(w, "%q: schema.MapAttribute{\n", name)
+		g.common(p, required, "")
+		fmt.Fprintf(w, "ElementType: %s,\n", elemType)
+		g.imports["github.com/hashicorp/terraform-plugin-framework/types"] = true
+	case p.Type == "array":
+		if p.Items == nil {
+			return fmt.Errorf("%s: array without items", key)
+		}
+		if p.Items.Type == "object" && p.Items.Properties != nil {
+			fmt.Fprintf(w, "%q: schema.ListNestedAttribute{\n", name)
+			g.common(p, required, "")
+			w.WriteString("NestedObject: schema.NestedAttributeObject{\n")
+			if err := g.attributes(p.Items); err != nil {
+				return fmt.Errorf("%s.%w", key, err)
+			}
+			w.WriteString("},\n")
+			break
+		}
+		elemType, err := elementType(p.Items)
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+		fmt.Fprintf(w, "%q: schema.ListAttribute{\n", name)
+		g.common(p, required, "")
+		fmt.Fprintf(w, "ElementType: %s,\n", elemType)
+		g.imports["github.com/hashicorp/terraform-plugin-framework/types"] = true
+		if len(p.Items.Enum) > 0 && p.Items.Type == "string" {
+			g.imports["github.com/hashicorp/terraform-plugin-framework/schema/validator"] = true
+			g.imports["github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"] = true
+			g.imports["github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"] = true
+			fmt.Fprintf(w, "Validators: []validator.List{\nlistvalidator.ValueStringsAre(stringvalidator.OneOf(%s)),\n},\n", enumValues(p.Items.Enum))
+		}
+	case p.Type == "string":
+		fmt.Fprintf(w, "%q: schema.StringAttribute{\n", name)
+		g.common(p, required, "")
+		if len(p.Enum) > 0 {
+			g.imports["github.com/hashicorp/terraform-plugin-framework/schema/validator"] = true
+			g.imports["github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"] = true
+			fmt.Fprintf(w, "Validators: []validator.String{\nstringvalidator.OneOf(%s),\n},\n", enumValues(p.Enum))
+		}
+	case p.Type == "integer":
+		fmt.Fprintf(w, "%q: schema.Int64Attribute{\n", name)
+		g.common(p, required, "")
+		if len(p.Enum) > 0 {
+			g.imports["github.com/hashicorp/terraform-plugin-framework/schema/validator"] = true
+			g.imports["github.com/hashicorp/terraform-plugin-framework-validators/int64validator"] = true
+			fmt.Fprintf(w, "Validators: []validator.Int64{\nint64validator.OneOf(%s),\n},\n", enumValues(p.Enum))
+		}
+	case p.Type == "number":
+		fmt.Fprintf(w, "%q: schema.Float64Attribute{\n", name)
+		g.common(p, required, "")
+	case p.Type == "boolean":
+		fmt.Fprintf(w, "%q: schema.BoolAttribute{\n", name)
+		g.common(p, required, "")
+	default:
+		return fmt.Errorf("%s: unsupported type %q", key, p.Type)
+	}
+	w.WriteString("},\n")
+	return nil
+}
+
+// attributes writes the `Attributes` field of a nested attribute.
+func (g *generator) attributes(p *schemas.Property) error {
+	keys := make([]string, 0, len(p.Properties))
+	for k := range p.Properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	g.body.WriteString("Attributes: map[string]schema.Attribute{\n")
+	for _, k := range keys {
+		if err := g.attribute(k, p.Properties[k], p.IsRequired(k)); err != nil {
+			return err
+		}
+	}
+	g.body.WriteString("},\n")
+	return nil
+}
+
+// common writes the description and whether the attribute is required.
+func (g *generator) common(p *schemas.Property, required bool, fallback string) {
+	desc := strings.Join(strings.Fields(p.Description), " ")
+	if desc == "" {
+		desc = fallback
+	}
+	enum := p.Enum
+	if p.Type == "array" && p.Items != nil {
+		enum = p.Items.Enum
+	}
+	if len(enum) > 0 {
+		values := make([]string, 0, len(enum))
+		for _, e := range enum {
+			values = append(values, fmt.Sprintf("`%v`", e))
+		}
+		if desc != "" {
+			desc = strings.TrimSuffix(desc, ":") + " "
+		}
+		desc += "(one of " + strings.Join(values, ", ") + ")"
+	}
+	if desc != "" {
+		fmt.Fprintf(&g.body, "MarkdownDescription: %s,\n", strconv.Quote(desc))
+	}
+	if required {
+		g.body.WriteString("Required: true,\n")
+	} else {
+		g.body.WriteString("Optional: true,\n")
+	}
+}
+
+func elementType(p *schemas.Property) (string, error) {
+	switch {
+	case p.IsIntOrString():
+		return "types.StringType", nil
+	case p.Type == "string":
+		return "types.StringType", nil
+	case p.Type == "integer":
+		return "types.Int64Type", nil
+	case p.Type == "number":
+		return "types.Float64Type", nil
+	case p.Type == "boolean":
+		return "types.BoolType", nil
+	}
+	return "", fmt.Errorf("unsupported element type %q", p.Type)
+}
+
+func enumValues(enum []interface{}) string {
+	values := make([]string, 0, len(enum))
+	for _, e := range enum {
+		if s, ok := e.(string); ok {
+			values = append(values, strconv.Quote(s))
+			continue
+		}
+		values = append(values, fmt.Sprintf("%v", e))
+	}
+	return strings.Join(values, ", ")
+}
+
+// snakeCase converts json keys (e.g. `sourceIP`) and kuma types (e.g. `MeshHTTPRoute`) to terraform names.
+func snakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// camelCase is the conversion done by the provider to get the json key of an attribute.
+func camelCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
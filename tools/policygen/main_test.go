@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import "testing"
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"MeshHTTPRoute":   "mesh_http_route",
+		"MeshTLS":         "mesh_tls",
+		"targetRef":       "target_ref",
+		"sourceIP":        "source_ip",
+		"traceId128bit":   "trace_id128bit",
+		"connectionLimit": "connection_limit",
+	}
+	for in, want := range tests {
+		if got := snakeCase(in); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}